@@ -0,0 +1,143 @@
+package core
+
+import (
+  "bufio"
+  "bytes"
+  "errors"
+  "fmt"
+  "os"
+  "regexp"
+  "strings"
+)
+
+const (
+  AllowFinding   = "finding"
+  AllowSignature = "signature"
+  AllowCommit    = "commit"
+  AllowPath      = "path"
+)
+
+type allowlistEntry struct {
+  kind       string
+  value      string
+  repository *regexp.Regexp
+  path       *regexp.Regexp
+}
+
+type Allowlist struct {
+  entries []allowlistEntry
+}
+
+// LoadAllowlistFile parses a file of findings to suppress. Each line holds one
+// entry and blank lines and lines starting with # are ignored:
+//
+//   finding <finding ID>
+//   signature <signature ID>
+//   commit <commit hash or prefix>
+//   path <owner/repository glob> <file path glob>
+func LoadAllowlistFile(location string) (*Allowlist, error) {
+  file, err := os.Open(location)
+  if err != nil {
+    return nil, err
+  }
+  defer file.Close()
+
+  allowlist := &Allowlist{}
+  scanner := bufio.NewScanner(file)
+  lineNumber := 0
+  for scanner.Scan() {
+    lineNumber++
+    line := strings.TrimSpace(scanner.Text())
+    if line == "" || strings.HasPrefix(line, "#") {
+      continue
+    }
+    entry, err := newAllowlistEntry(strings.Fields(line))
+    if err != nil {
+      return nil, errors.New(fmt.Sprintf("%s:%d: %s", location, lineNumber, err))
+    }
+    allowlist.entries = append(allowlist.entries, entry)
+  }
+  if err := scanner.Err(); err != nil {
+    return nil, err
+  }
+  return allowlist, nil
+}
+
+func newAllowlistEntry(fields []string) (allowlistEntry, error) {
+  entry := allowlistEntry{kind: fields[0]}
+  switch entry.kind {
+  case AllowFinding, AllowSignature, AllowCommit:
+    if len(fields) != 2 {
+      return entry, errors.New(fmt.Sprintf("expected: %s <value>", entry.kind))
+    }
+    entry.value = strings.ToLower(fields[1])
+  case AllowPath:
+    if len(fields) != 3 {
+      return entry, errors.New("expected: path <owner/repository glob> <file path glob>")
+    }
+    entry.repository = GlobToRegexp(fields[1])
+    entry.path = GlobToRegexp(fields[2])
+  default:
+    return entry, errors.New(fmt.Sprintf("unknown entry type: %q", entry.kind))
+  }
+  return entry, nil
+}
+
+func (e allowlistEntry) matches(finding *Finding) bool {
+  switch e.kind {
+  case AllowFinding:
+    return e.value == finding.Id
+  case AllowSignature:
+    return e.value == strings.ToLower(finding.SignatureID)
+  case AllowCommit:
+    return strings.HasPrefix(finding.CommitHash, e.value)
+  case AllowPath:
+    repository := fmt.Sprintf("%s/%s", finding.RepositoryOwner, finding.RepositoryName)
+    return e.repository.MatchString(repository) && e.path.MatchString(finding.FilePath)
+  }
+  return false
+}
+
+func (a *Allowlist) IsSuppressed(finding *Finding) bool {
+  if a == nil {
+    return false
+  }
+  for _, entry := range a.entries {
+    if entry.matches(finding) {
+      return true
+    }
+  }
+  return false
+}
+
+func (a *Allowlist) Len() int {
+  if a == nil {
+    return 0
+  }
+  return len(a.entries)
+}
+
+// GlobToRegexp converts a glob where * matches within a path segment and **
+// matches across segments into an anchored regular expression.
+func GlobToRegexp(glob string) *regexp.Regexp {
+  var pattern bytes.Buffer
+  runes := []rune(glob)
+  pattern.WriteString("^")
+  for i := 0; i < len(runes); i++ {
+    switch runes[i] {
+    case '*':
+      if i+1 < len(runes) && runes[i+1] == '*' {
+        pattern.WriteString(".*")
+        i++
+      } else {
+        pattern.WriteString("[^/]*")
+      }
+    case '?':
+      pattern.WriteString("[^/]")
+    default:
+      pattern.WriteString(regexp.QuoteMeta(string(runes[i])))
+    }
+  }
+  pattern.WriteString("$")
+  return regexp.MustCompile(pattern.String())
+}
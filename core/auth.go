@@ -0,0 +1,110 @@
+package core
+
+import (
+  "bufio"
+  "crypto/rand"
+  "crypto/subtle"
+  "encoding/hex"
+  "errors"
+  "fmt"
+  "net/http"
+  "os"
+  "strings"
+
+  "github.com/gin-gonic/gin"
+  "golang.org/x/crypto/bcrypt"
+)
+
+const (
+  AuthTokenLength = 32
+  AuthRealm       = "Gitrob"
+)
+
+// Credentials maps usernames to the bcrypt hashes of their passwords.
+type Credentials map[string][]byte
+
+// GenerateAuthToken returns a random hex encoded token for bearer
+// authentication of the web interface and API.
+func GenerateAuthToken() (string, error) {
+  token := make([]byte, AuthTokenLength)
+  if _, err := rand.Read(token); err != nil {
+    return "", err
+  }
+  return hex.EncodeToString(token), nil
+}
+
+// LoadCredentialsFile reads users for basic authentication from an htpasswd
+// file with one username:hash entry per line, as written by htpasswd -B.
+// Only bcrypt hashes are supported, and blank lines and lines starting with
+// # are ignored.
+func LoadCredentialsFile(location string) (Credentials, error) {
+  file, err := os.Open(location)
+  if err != nil {
+    return nil, err
+  }
+  defer file.Close()
+
+  credentials := make(Credentials)
+  scanner := bufio.NewScanner(file)
+  lineNumber := 0
+  for scanner.Scan() {
+    lineNumber++
+    line := strings.TrimSpace(scanner.Text())
+    if line == "" || strings.HasPrefix(line, "#") {
+      continue
+    }
+    parts := strings.SplitN(line, ":", 2)
+    if len(parts) != 2 || parts[0] == "" {
+      return nil, errors.New(fmt.Sprintf("%s:%d: expected username:hash", location, lineNumber))
+    }
+    if _, err := bcrypt.Cost([]byte(parts[1])); err != nil {
+      return nil, errors.New(fmt.Sprintf("%s:%d: password of %s is not a bcrypt hash", location, lineNumber, parts[0]))
+    }
+    credentials[parts[0]] = []byte(parts[1])
+  }
+  if err := scanner.Err(); err != nil {
+    return nil, err
+  }
+  if len(credentials) == 0 {
+    return nil, errors.New(fmt.Sprintf("%s has no users", location))
+  }
+  return credentials, nil
+}
+
+// Authenticate reports whether the password is correct for username.
+func (c Credentials) Authenticate(username string, password string) bool {
+  hash, ok := c[username]
+  if !ok {
+    return false
+  }
+  return bcrypt.CompareHashAndPassword(hash, []byte(password)) == nil
+}
+
+// AuthRequired returns a middleware that only lets through requests with
+// the bearer token or the username and password of one of the credentials.
+// An empty token or nil credentials disable that kind of authentication.
+func AuthRequired(token string, credentials Credentials) gin.HandlerFunc {
+  return func(c *gin.Context) {
+    header := c.GetHeader("Authorization")
+    if token != "" && strings.HasPrefix(header, "Bearer ") {
+      given := strings.TrimPrefix(header, "Bearer ")
+      if subtle.ConstantTimeCompare([]byte(given), []byte(token)) == 1 {
+        c.Next()
+        return
+      }
+    }
+    if credentials != nil {
+      if username, password, ok := c.Request.BasicAuth(); ok && credentials.Authenticate(username, password) {
+        c.Next()
+        return
+      }
+      c.Header("WWW-Authenticate", fmt.Sprintf("Basic realm=%q", AuthRealm))
+    } else {
+      c.Header("WWW-Authenticate", fmt.Sprintf("Bearer realm=%q", AuthRealm))
+    }
+    c.JSON(http.StatusUnauthorized, gin.H{
+      "message": "Authentication required",
+    })
+    c.Abort()
+  }
+}
@@ -273,7 +273,7 @@ func staticImagesSpinnerGif() (*asset, error) {
 	return a, nil
 }
 
-var _staticIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x6d\x6f\xdc\xb8\x11\xfe\x9e\x5f\x31\xc7\x62\x0f\x09\x70\x5a\x39\x35\x0e\x28\x1c\x69\xd1\x34\xce\x5d\x0c\x5c\x92\x43\xe2\x16\xe8\xa7\x05\x25\xce\x4a\x8c\x29\x52\x25\x29\xaf\xdd\xa2\xff\xfd\x40\x52\xd2\xea\x6d\x7d\x59\x27\x01\x02\x04\x6b\x91\x9c\x37\x3e\x1c\x0e\x67\xc8\x24\x3f\x30\x95\xdb\xfb\x1a\xa1\xb4\x95\xd8\x3c\x49\xdc\x1f\x10\x54\x16\x29\x41\x49\x36\x4f\x00\x92\x12\x29\x73\x1f\x00\x49\x85\x96\x42\x5e\x52\x6d\xd0\xa6\xa4\xb1\xbb\xe8\x6f\x64\x38\x24\x69\x85\x29\xb9\xe5\xb8\xaf\x95\xb6\x04\x72\x25\x2d\x4a\x9b\x92\x3d\x67\xb6\x4c\x19\xde\xf2\x1c\x23\xdf\xf8\x09\xb8\xe4\x96\x53\x11\x99\x9c\x0a\x4c\x9f\xff\x04\xa6\xd4\x5c\xde\x44\x56\x45\x3b\x6e\x53\xa9\x16\x44\x33\x34\xb9\xe6\xb5\xe5\x4a\x0e\xa4\xff\xca\xad\x56\xd9\x05\xfc\xde\x58\xcb\x65\x01\xb6\x44\x78\x5f\xa3\x84\x8f\xaa\xd1\x39\x02\x97\xf0\xfe\xe3\xd5\xbb\xeb\x05\x81\xb4\xb1\xa5\xd2\x03\x59\x6f\x79\x5e\x52\x14\xf0\x06\xa5\xe6\x37\x06\x25\x3c\xfd\x7b\xc5\xf3\xb2\x6b\x3e\x23\x9b\x27\x41\x8a\xe5\x56\xe0\x26\xe8\x4e\xe2\xd0\x6a\x87\x04\x97\x37\x50\x6a\xdc\xa5\x24\x36\xf6\x5e\xa0\x29\x11\xad\x89\x33\xa5\xac\xb1\x9a\xd6\xeb\xdc\x18\x02\x1a\x45\x4a\x0e\xe3\x9d\x79\xc7\xb8\x55\x8d\x92\xe7\x4a\xf2\xfc\x51\xec\x25\x2f\x4a\xc1\x8b\xd2\x3e\x8a\x9b\xd6\xb5\xe0\x39\x75\xc8\x1f\xe7\x4f\xe2\xe0\x2c\xee\x33\x53\xec\xbe\xc3\x43\xd2\x5b\xc8\x05\x35\x26\x25\x92\xde\x66\x54\x43\xf8\x13\xe1\x5d\x4d\x25\x8b\x2a\xd6\x75\x78\x03\x21\x2b\xc2\x47\x6b\x14\x40\xc2\x78\x2f\xc1\x2d\x15\xe5\x12\x75\x3f\x0a\x90\xd0\xb1\xfc\x28\xd3\x54\x32\xd2\x4d\x64\x48\xc9\xab\x02\x8c\xce\x53\x12\xf3\x8a\x16\x68\xe2\x42\xd5\x25\xea\xad\xb3\x7c\x5d\xcb\x82\x40\x70\x56\x72\x7e\x46\xa0\x44\x67\x46\x4a\xfe\x7a\x46\x3a\x05\x2c\xe2\x52\x70\x89\x51\x26\x54\x7e\x43\x80\x0a\x9b\x92\x81\x82\xce\x21\xe8\x40\x67\xd6\x58\xab\xe4\xc4\x44\xab\x8a\x42\xa0\x26\xe0\xf6\x5f\x4a\x02\x0d\x01\x46\x2d\x6d\xc7\xdc\x5c\x85\xa0\xb5\x41\x02\x54\x73\xda\xc2\x85\x2c\x25\x3b\x2a\xfa\x5e\x41\x33\xb7\x16\xd7\x9e\xc7\x01\xc9\x0b\xbf\x4e\x03\xa3\x00\x12\x53\xd3\x23\x16\x44\xce\xa9\xc8\x26\x89\x1d\xc9\xc0\xea\x38\x98\xd4\xaf\x41\xcc\xf8\x6d\xeb\x25\xb1\xa4\xb7\xdd\xe2\x56\x94\x4b\xd0\xca\x99\xeb\x3e\xc9\xf1\x75\x4a\x32\x0d\xf1\x68\x49\x39\x73\x3e\x44\xad\xd9\x2e\xae\xea\x60\xd5\x6b\xad\x0a\x8d\xce\xf1\xbc\xcf\xa5\x24\x2c\xcd\x05\x9c\x9f\xd5\x77\x2f\xc6\x53\x5d\x60\x8b\x9c\xd3\x0d\x1b\x91\xb1\x9a\xd7\xc8\xc6\x9d\x54\xf2\x8a\x5a\x64\xa4\x9d\x50\x37\x98\x51\x4d\xbc\xb1\x5d\xc7\xd6\xf7\xb4\xa6\x78\x87\xb9\x80\xe7\x67\x67\xab\x17\xed\x9a\xdc\x52\xd1\xa0\x54\xfb\x94\x3c\x3f\x3b\x1b\xf6\x55\x5c\xa6\x64\xdc\x43\xef\x02\xd5\xe6\x2a\x44\x44\xfe\x5f\x2e\x8b\xf5\x7a\x3d\x00\x7c\x82\xff\x0c\xcc\xf1\xa4\xb5\xda\x1f\x05\x24\x57\x22\x32\xd5\x68\x78\x42\x40\x35\x03\x8b\x77\x36\xca\x51\x5a\x6c\xe7\xed\x7a\xb7\x3b\x2e\x19\x97\x85\x99\x70\xcf\xf9\x23\xb7\xf9\x67\x54\xee\x2c\x39\x1f\x91\xf9\xa0\xb9\xa0\x60\xeb\x81\x21\x9b\xb3\x24\x2e\xcf\x17\xc4\xd4\x63\x29\x78\x67\x97\x84\xb8\xc3\x82\x6c\x7e\x69\x9b\x49\x5c\xcf\xcc\x1e\x23\xba\xd8\x35\xef\xf8\x6a\x60\x0a\xfc\x96\x48\x0a\xfc\x52\x18\x9d\x84\x0e\x43\x81\xdf\x1d\x80\xb9\xaa\x2a\x6e\xbf\x1d\x84\xad\xfc\x2f\x02\xb1\x93\x11\x60\x7c\x15\x5a\xdf\x1b\x90\x1a\x6b\x65\xb8\x55\x9a\x7f\x43\x87\x1c\x2a\xf9\x22\x48\x47\x82\x02\xae\x1f\x06\x5d\xdf\x1b\xb8\x96\xea\x02\xbf\xa1\x97\xb6\xf2\xbf\x08\xd2\x4e\x46\x40\xf3\x3a\xb4\xbe\x37\x20\x59\xa3\xe7\x59\xcd\xd7\x44\xb2\x53\xd0\x43\x79\x76\xe1\xff\x3d\x06\xd1\x5e\x56\x80\xf4\xb2\x6d\x7e\x1d\x4c\x47\xcd\xb6\x31\xce\xb0\xba\x96\xc1\xdc\xa9\x0d\x99\x0b\x2d\x70\xe9\x04\x4f\xc6\xb3\xeb\x8e\xcb\xa1\x7a\x2e\xeb\xc6\x76\xd3\xdd\x29\x5d\x45\x2e\x5b\xd3\x4a\xc0\xb0\x11\x99\x0a\x76\x42\x51\x1b\x69\x9f\xbb\xb7\x79\x6d\x40\xa6\x16\x34\xc7\x52\x09\x86\x3a\x25\x1f\x91\xea\xbc\x5c\xaf\xd7\x01\xb1\xfe\xc0\x36\xbe\x7f\x68\x9b\x87\xfe\xd0\xb4\x34\x13\xd8\x19\x12\x1a\xfe\xd7\xa9\x0e\x1f\xa5\xba\x45\xdd\x75\x86\x0c\x2f\x28\xf1\x5d\xcb\x19\x4c\x62\x0f\x25\xee\xa1\x4f\xcf\x56\xca\x96\x60\x72\x55\x87\xb4\x9c\x0c\x7d\x9a\xe6\xc1\x33\x5f\xe6\x61\x95\x6d\x79\x02\x73\x4d\x6d\x49\x36\xbf\x53\x5b\x9e\xc8\x18\x0e\x97\xee\x58\x39\x91\xb9\x0f\xa3\xf7\x83\xf8\x79\x3f\x17\x92\xc4\x63\x24\x1c\xc5\x04\xad\xc4\x86\x5a\x6f\x44\x34\xee\x4a\x62\x8f\xff\xc1\x69\x5b\xcf\xec\xca\x09\x57\x38\x6c\x92\x1f\xa2\x08\xe2\x75\x5f\x09\x40\x14\x75\x35\xc6\x4e\x29\x8b\xfa\xc1\x6a\x70\x18\x36\xc2\x77\xd5\xb8\x4c\x7e\x54\x24\x86\x7a\xb0\xb4\xb6\x36\x17\x71\x5c\x70\x5b\x36\xd9\x3a\x57\x55\x3c\x2c\xf1\x5d\xbf\x56\x19\x81\x10\x17\x53\xb2\xcd\x04\x95\x37\x64\x73\x28\xed\x80\x1b\xa0\xae\x74\xf8\x84\xb9\x85\xec\x7e\x2c\xfb\x22\x1e\xc9\x73\x0a\xe6\xc2\x66\x17\x0d\x5e\xee\x8f\x15\x67\x4c\xd9\x17\xa7\x1a\x1b\x73\x63\x1a\x34\xb1\xc4\xfd\x5c\x95\x5b\x5f\x6d\x81\x4a\xf0\x54\x83\xda\x74\x54\xd3\x75\x20\x87\x66\xb8\x68\x19\x6c\xe2\xd8\x62\x55\x0b\x6a\xdb\x98\xd9\xb5\xba\x3d\x75\xa8\xf2\x2c\x5b\xda\x1b\x87\x65\x58\x01\xdf\xc1\xd3\xb0\x57\x20\x4d\x81\xbc\x55\x8c\xef\xee\xc9\x33\xf8\x1f\xac\x8e\xd6\xac\x19\x65\x05\x82\xff\x8d\x6a\xcd\x2b\xea\x3c\xf7\xed\xfb\xcb\xab\x5f\xfe\x3d\xab\x5c\x57\xf0\x7f\x40\x61\x70\xaa\xe8\x4a\x1a\xd4\xf6\x04\x45\xa6\xc9\x73\x57\x74\x6e\x5e\x7d\x78\xfd\xf2\xfa\xf5\x67\x2b\xba\x44\x81\x16\x4f\x50\xc4\xa8\x2c\x5c\xed\x7b\xf9\xfa\xb7\xd7\x47\xf4\xac\x0e\x8b\x66\xd9\x11\xb0\x43\x2c\x49\x72\xc5\x70\xc1\xef\xff\x42\x36\xc9\x2a\x05\x5b\x72\xb3\x76\x91\x9b\x5a\x8b\xcc\xe5\xf6\x2e\xf8\x3c\x7d\x06\xab\xcd\xc8\x35\xbc\x94\x07\x94\x75\xf1\x27\xa8\xeb\xb5\x24\xab\x08\x42\x48\xfa\xa7\x16\xb0\xda\xb4\x57\x45\x52\xa9\x1a\xdd\x3e\x95\x4a\xe3\x0e\xb5\xbf\xf9\x98\x38\x6a\x6f\x5d\xa5\x18\x8a\xb5\x29\x95\xb6\x41\xd4\x1b\x6a\x0e\x16\x1e\x4c\x2b\x8f\x98\x36\x8c\x6e\x23\xc3\x0e\xa1\xee\x11\xc6\x0d\xd9\xdf\xef\x1d\xf9\x6a\x13\x8f\xbb\xdf\xd1\x0a\x7b\x2b\x3b\xf3\x92\x38\x6c\xa6\xc7\x6e\xad\x6d\xa5\x18\x15\x8b\x97\x61\x7e\x24\x72\x11\x79\x7c\x73\x52\xfe\x3c\xa6\x08\xc9\x8e\x9f\xc3\xe5\xe1\x0e\xd5\x5b\x5a\xfe\x3c\xbf\xa9\x1a\x5f\x49\x75\xc0\x0a\x65\xb0\xbd\xa0\x62\xdc\x54\xbc\x17\x3f\xbe\x88\x7a\xe5\xe9\xe6\x6e\xef\x69\x4a\xce\x18\xca\x94\x58\xed\x72\xac\x1f\x2d\xaf\xd0\xbc\x38\xe1\xea\x69\x69\xfa\x93\x84\xaf\x0d\x30\xde\x91\xb8\xb9\x46\x63\x3f\xa0\x83\x93\x3d\x7d\x36\xdf\x90\x03\x61\x54\xa0\x8b\x92\xee\x37\xda\x53\x2d\x5d\x50\x6b\xef\x81\x7c\x27\xd9\x24\xc6\x6a\x25\x8b\xcd\x3b\x65\x79\x8e\x17\x49\xdc\xb6\xe1\xba\xe4\x06\x5c\xc5\x0c\x42\xa9\x1b\x03\x56\x41\x86\x60\xd1\xf8\xfb\x68\x1d\xd4\xcf\x2e\x74\x46\xbb\x7a\x6c\x4b\x66\x65\x54\x68\xd5\xd4\xd0\x7f\x4d\xf3\xab\xd1\x34\x16\xd7\x6d\x90\x5c\x6d\x6f\x39\xee\xb7\x9a\xee\xc9\x40\x83\x97\x6d\x30\x57\x92\xf9\x68\xfa\x81\xee\xa7\xc8\x9f\x20\xbc\xc4\x3b\xd6\x54\xf5\x43\x0a\xde\xe0\x1d\x38\x9a\xb9\x96\x29\x34\xa3\x4c\xaf\x55\x13\x55\x68\x69\xe4\x47\x26\xf9\x9b\x9e\x26\x6f\xa5\xcf\xa7\x2e\x16\xd2\x19\xcb\xba\x78\xd5\xae\xdd\xf2\xb6\xee\x97\x36\x5e\xa6\xeb\xf7\x79\x4f\xb6\x8a\xa0\x0b\xa5\x7e\x60\x16\x3d\x61\x29\x9b\x5a\x32\xfd\xa5\x7f\x93\x38\x66\x7c\x1f\x5d\x03\x99\xd7\xf5\x08\x25\x6f\xd1\x18\x5a\xe0\xb2\x96\x43\xae\x2f\x6d\xc4\x2d\x15\x3c\x1f\x04\x67\xab\x1b\x99\x3b\x87\x0e\x76\xb4\x92\xda\xe8\xfc\x08\x53\xae\x2e\x8f\xcc\x75\x9a\xcd\x06\x48\x57\x11\x5c\xb1\x03\xc4\x53\xa2\xd6\x59\x87\xee\xc9\xd9\x36\x17\xbc\xce\x14\xd5\x6c\xe6\x9e\xaa\xb1\xfe\x3a\xbf\x77\xd3\xe0\xb4\x55\x1b\xe8\x7a\x46\x5f\xe2\x85\x43\xc4\xab\x77\xd1\x60\x70\x98\x2b\x0e\x8a\x1f\xa8\xfb\xeb\xf4\xa5\x0d\x35\x3e\xc2\xe7\x38\x4d\xb2\x65\x17\xce\x8f\xde\xf5\xce\x8a\x65\x1f\x12\xfd\xed\xdd\xd6\xd4\x5c\x4a\xd4\x8b\x77\xeb\xed\x4b\x48\x2b\xa5\xa5\x24\xe3\x97\x91\xb6\x77\x5d\xf0\x5d\xfb\xce\xf1\x9b\xa2\x0e\xd1\x10\xea\xda\x37\x33\xd3\x17\x72\x73\xd5\x64\x68\xb6\x2b\x9a\x37\xc7\x24\x8c\x4a\xe3\x69\x34\xe8\x9e\x0a\x06\x0a\x3a\xd6\x63\x93\xab\x35\x1e\x63\x71\x6b\x53\x6b\xfc\x33\xf2\x2e\x9e\x4d\xa9\x97\xca\xef\xf9\xba\x84\x93\x29\xa4\xd4\xc7\x1f\x62\x0e\x75\x0a\x0c\xf6\x5a\xf8\xde\xfb\x07\x8e\xee\x21\x6c\xc1\xd9\xfc\x48\xd6\x88\xac\x77\x36\xb8\xe6\xf5\x05\xfc\x43\xab\xbd\x41\xe8\x6a\x5d\x57\x9e\x34\xa6\x7b\x17\x5d\x90\x43\xb5\x56\xfb\x48\xe0\xce\x1e\x04\x51\xc9\x8e\x93\xb6\xe7\x4f\x4f\xeb\x3a\xe1\x06\xef\xcd\x7a\x7a\x90\x0f\x92\xcf\x2e\x40\x3e\x98\x77\x2d\x25\x5e\xd3\x0d\xdb\x25\xff\xed\xd1\xdc\x9e\x47\x9b\x7f\x71\xdc\x07\xaf\x52\x12\x7e\xe5\xf6\x4d\x33\x7e\x84\x9b\x98\xf2\x19\x19\xea\xe7\x18\x73\x38\xdc\x96\xcc\x09\x19\xf2\xa2\x41\xa3\xca\x6b\x9c\x1e\x4e\xbd\xc8\x19\x91\x71\xc9\xf0\x2e\x25\xd1\xf3\x4e\x11\xe3\x54\xa8\x62\x7c\x0e\xff\x59\x9e\x18\x78\x20\x34\x44\x9f\xdd\x30\x95\x37\x15\x4a\x7b\xe4\xe9\x2d\x90\xb7\xbb\xc7\x2d\xfb\xb2\xff\x0f\x6f\xa2\xba\x14\x37\x84\x93\x4f\xf4\x96\x86\x0e\x13\x7f\xfa\x4f\x83\xfa\x3e\x3a\x5f\x9f\xaf\x9f\xaf\x3f\xf9\xbd\xd8\xcd\xfe\x61\xc6\x46\x32\xd4\x26\x57\x1a\x4f\x62\xcb\x68\x7e\x93\x29\x79\x1a\x53\xad\xea\x1a\xf5\x69\x7a\xfa\xa7\xfd\x53\xb8\xfa\xf3\xe2\x24\xae\x36\x32\x9d\xc4\x33\x7c\xbf\x9f\xf2\x25\x71\xb8\xad\x49\xe2\xf0\xbf\x40\xfe\x08\x00\x00\xff\xff\x2b\xde\x8c\x56\x16\x22\x00\x00")
+var _staticIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xe5\x1b\x6b\x6f\xdb\x38\xf2\xfb\xfe\x0a\xae\x0e\x59\x24\x40\x65\xa7\x5b\x2c\xee\x90\xda\xbe\xcb\x25\x69\x6b\x5c\x9b\x14\x49\xba\x8b\xfd\x64\xd0\x12\x6d\xb1\x91\x44\x2d\x49\xc5\xc9\x1d\xf6\xbf\xdf\x0c\x29\xca\x7a\x3a\x56\xda\x1e\x0a\x1c\xd0\x3a\x12\xc9\x79\x70\x66\x38\x1c\x0e\x47\x93\x1f\x43\x11\xe8\xc7\x8c\x91\x48\x27\xf1\xec\x87\x09\xfe\x21\x31\x4d\xd7\x53\x8f\xa5\xde\xec\x07\x42\x26\x11\xa3\x21\x3e\xc0\x63\xc2\x34\x25\x41\x44\xa5\x62\x7a\xea\xe5\x7a\xe5\xff\xcd\xab\x76\xa5\x34\x61\x53\xef\x9e\xb3\x4d\x26\xa4\xf6\x48\x20\x52\xcd\x52\x18\xba\xe1\xa1\x8e\xa6\x21\xbb\xe7\x01\xf3\xcd\xcb\x0b\xc2\x53\xae\x39\x8d\x7d\x15\xd0\x98\x4d\x5f\xbe\x20\x2a\x92\x3c\xbd\xf3\xb5\xf0\x57\x5c\x4f\x53\xd1\x81\x3a\x64\x2a\x90\x3c\xd3\x5c\xa4\x15\xec\x6f\xb9\x96\x62\x79\x42\x3e\xe6\x5a\xf3\x74\x4d\x74\xc4\xc8\x55\xc6\x52\x72\x23\x72\x19\x30\xa0\x44\xae\x6e\xe6\x97\xb7\x1d\x08\x69\xae\x23\x21\x2b\xb8\x3e\x70\x98\x1f\x8b\xc9\x3b\x96\x4a\x7e\xa7\x00\xc9\xe1\x3f\x12\x68\x73\xaf\x47\x80\xc4\x62\xd1\x5c\xc7\x6c\x66\x69\x4f\xc6\xf6\xad\xe8\x8a\x61\x1e\x24\x92\x6c\x35\xf5\xc6\x4a\x3f\xc6\x4c\x45\x8c\x69\x35\x5e\x0a\xa1\x95\x96\x34\x1b\x05\x4a\x79\x44\xb2\x78\xea\x6d\xfb\x1d\x7b\x7d\xd0\x02\xa6\xc4\x81\x51\x1e\x3c\x0b\x3c\xe2\xeb\x28\x86\xff\xfa\x59\xd0\x34\xcb\x62\x1e\x50\x94\x7c\x3f\xfc\x64\x6c\x8d\x05\x1f\x97\x22\x7c\x74\xf2\x48\xe9\x3d\x09\x62\xaa\xd4\xd4\x83\xc7\x25\x95\xc4\xfe\xf1\xd9\x43\x46\xd3\xd0\x4f\x42\xd7\x60\x18\x24\xcb\xb5\x7d\x28\x98\x02\x0c\x21\x2f\x31\xa0\xaa\x28\x4f\x99\x2c\x7b\xa1\x9f\xd6\xf1\xfb\x4b\x09\x78\x3d\x37\x91\xea\x48\x9e\xac\x89\x92\x01\xb4\xf2\x84\xae\x99\x1a\xaf\x45\x16\x31\xb9\x40\xce\x47\x59\xba\xf6\x88\x35\x56\xef\xd5\x31\xc0\x33\x64\x63\xea\xfd\x0c\xcf\x05\x81\xd0\xe7\x29\x08\x89\xf9\xcb\x58\x04\x77\x1e\xa1\x31\xf4\x57\x08\x38\x83\xa0\x15\x9a\x4b\x30\x4c\x91\x36\x58\xd4\x62\xbd\x8e\x61\x16\x04\xd7\xdf\xd4\xb3\x63\x3c\x12\x52\x4d\x8b\x3e\x9c\x6b\x1c\xd3\x4c\x31\x20\x23\x39\x2d\xc4\xc5\xc2\xa9\xb7\xa2\x71\xd9\x1a\xd3\x25\xea\xe2\xd6\xc0\xa0\x20\xf9\xda\xe8\xa9\xc2\x14\xf0\xa0\x00\xb4\x9b\x03\x1f\x8d\xca\x9b\x4d\xc6\x38\xa4\xc2\xf5\xd8\xb2\x54\xea\x60\x0c\x4a\x28\xac\x64\x0c\x18\x9c\x72\x13\x50\x06\x91\x02\xd9\xc5\x47\xaf\x5f\x4f\x93\xa5\x24\xe3\x9a\x4a\x79\x88\x36\x44\xb5\x5a\x74\x6a\xb5\xa2\xf5\x4c\x8a\xb5\x64\x68\x78\xc6\xe6\xa6\x9e\x55\xcd\x09\x79\x75\x9c\x3d\xbc\xae\x4f\xb5\x03\xcc\x47\xa3\xab\xbe\xf8\xb0\x0e\x79\xc6\xc2\x7a\x23\x4d\xc1\x28\x34\x03\xcb\xb1\x13\x72\x9d\xd0\xe7\x19\x66\x5d\xc3\xc2\xb4\x14\xac\x18\x83\x39\x21\x2f\x8f\x8f\x0f\x5e\x17\x3a\xb9\xa7\x71\xce\x52\xb1\x99\x7a\xd0\x5a\x6d\x4b\x78\x3a\xf5\xea\x2d\xf4\xc1\x8e\x9a\xcd\xad\x47\xe4\xff\x06\x27\x36\x1a\x8d\x2a\x02\x6f\xc8\xbf\x39\x4b\x70\xa0\x52\x13\xf3\xeb\x87\xe0\xbc\x99\x24\xa1\x9f\x8a\x94\xb9\x79\x98\x2e\x3b\x03\xf4\x78\x0b\xc9\xfe\xc8\xb9\x84\x79\xce\x4e\xe1\x15\x1c\x5f\xb1\xb4\x89\xeb\x18\x59\x07\x8a\x9e\x74\xc3\x96\xe0\x41\x35\x93\x2b\x0a\xbe\x74\xc3\x75\x64\x9a\x8d\xa3\xc8\x24\xf6\x84\x64\x03\x38\x0a\xcb\x07\xa1\x50\x09\x6d\x2f\x88\x90\x44\xf1\x75\x8a\xee\xd7\x40\x51\x92\x2b\xe0\x6c\x25\x45\x62\x30\xf8\xc8\x0a\xf8\xfa\x98\xb5\xa6\xaa\x12\x1a\xc7\x6e\x76\x9a\x3d\x68\x3f\xc9\x8d\x5a\x70\x06\x12\x34\xb4\x88\x79\xc2\x75\xa9\x81\x90\xab\x2c\xa6\x8f\x27\x04\x27\xfd\xda\xd8\x32\x62\xd8\x89\xb1\x10\xd4\x2a\x16\x54\xfb\xd2\xb8\x1b\x2b\xa0\x94\xc6\x8f\x8a\xab\x05\x93\x52\x48\xd5\x4f\x83\x3a\xf7\xe2\x06\xb6\xb1\xc3\xe2\xa6\x72\x8d\x9b\xe5\x62\x09\xbb\xea\x1d\x72\x46\x3b\xb8\xab\x2e\x8c\xba\x6a\xa5\xd8\xf4\x1a\x37\x78\x07\x5f\x25\xb5\xee\xc6\x00\x2a\x43\x62\xd8\x09\x18\x6a\xd0\x4e\x10\x5b\x17\x2b\x9e\x86\x60\x66\xaa\x01\xdd\x86\xf7\xd1\x91\xb7\x46\x61\x5c\xf0\xaa\x36\xcc\x6c\x80\x1d\x04\x16\xc6\xc8\xbd\xd9\x31\x6c\x0e\xaf\x3a\xd0\x64\x75\x2c\xc0\x6c\x17\x12\xdc\xf8\xbd\xd9\x9b\xe2\x75\x32\xce\x5a\x6c\xd7\x2d\xa8\xb3\xa9\xdd\xf0\xd5\x84\x09\xbb\xe0\x37\x94\x24\x60\xff\x42\x31\x22\x06\x27\x43\x78\xfe\xde\x04\x18\x88\x04\x96\xf3\xb7\x13\x61\x81\xff\x8b\x84\xe8\x70\x58\x31\x9e\xd9\xb7\xef\x4d\x90\x92\x65\x42\x71\x2d\x24\xff\x86\x06\x59\x25\xf2\x45\x22\xad\x21\xb2\x72\xbd\xae\x34\x7d\x6f\xc2\xb5\xbe\xfc\xdb\xc9\xb5\xc0\xff\x45\x22\x75\x38\xac\x34\x6f\xed\xdb\xf7\x26\xc8\x30\x97\xed\x08\xf5\x6b\x4a\xd2\x11\x28\x45\x79\x7c\x62\xfe\x3d\x47\xa2\x25\x2e\x2b\xd2\xf3\xe2\xf5\xeb\xc8\xb4\xf6\x5a\xbc\xd4\xa3\x65\xf7\xa6\x58\x60\x22\x34\x13\x85\xc2\xc1\xa5\x6b\x07\x9f\xd4\x67\xe7\xb6\xcb\x2a\x79\x9e\x66\xb9\x76\xd3\x5d\x09\x99\xf8\x18\x79\x43\x94\x48\xaa\x2f\xa0\xd9\x7a\x60\x64\xcf\x28\x56\x32\x10\x05\x05\x2c\x12\x71\xc8\xe4\xd4\xbb\x61\x54\x06\x11\x44\xab\x56\x62\xe5\x86\xad\x4c\x7b\xe3\x08\xc2\x62\x98\xc4\x70\xe2\x0d\xc4\xf7\x4c\x72\xdd\xb4\x8a\x89\x30\x59\x01\x62\x14\x8e\x07\xb2\x53\x08\xf7\x8a\xb1\xc6\x95\xd8\xfe\x9d\x40\x09\x0b\x79\x0e\x26\xfd\xc1\xfc\x25\x70\xd4\x22\x74\x29\xee\xd9\x5e\xc0\x78\xbc\xf6\x66\xef\xe0\x77\x20\x60\x80\x1c\x06\x34\x86\x3d\xa5\x78\xea\x02\x83\x98\xd1\x48\xef\x2b\x08\xb4\x3c\x1b\xd4\xe4\x1a\xf2\xd5\x6a\xb7\x4c\x21\x64\xb5\x62\x5d\x95\x61\xd8\x1e\xd3\x4b\x19\x04\xb0\x97\x6c\x33\x0c\x2a\x4f\x83\x08\x23\x68\x38\xa1\x7c\x72\x8f\xc3\x30\xc0\x29\x4d\xc4\xf7\x88\xe0\xba\x78\xda\x09\xdf\x96\x6f\xdd\x57\xf4\x1d\xb7\x78\xba\x12\xfd\x87\x2d\x14\xea\x42\xe5\x49\x42\xe5\x23\xc6\xfe\xd5\xf5\x8d\x29\x24\xba\x84\x13\xbb\x3b\x36\x98\x17\xf3\x8b\x0a\xb3\x0f\x11\x58\x91\x74\x8d\xf6\xc4\x6a\x31\x9b\xa6\xee\x28\x7e\xa2\xb7\x29\xbb\x6d\x9b\x6c\x79\x2b\x38\x92\xa9\x40\x64\x36\xcd\xe0\xd5\xfc\x7a\xb9\xc6\x6e\x8a\xa7\xc9\x58\x47\x03\x10\xd0\xc0\xba\xf7\xd3\xc0\xba\xca\x41\xc0\x19\xd5\xb0\x92\x3e\xc2\xef\x40\x40\x1b\xa1\xb9\xd8\x6c\x20\x70\x19\x8b\x3c\x56\x82\x90\x8e\x79\x43\x8b\xac\x1b\x4e\x4b\xdc\x13\x6d\x93\x5f\xb5\x41\xf5\x26\x68\x40\x05\x76\xdb\x57\x65\xd3\xec\x5e\xab\x89\x90\xac\xae\xf2\xac\xef\xb0\x5c\xc2\x04\x22\x4f\x35\xda\x60\x6d\xdb\x72\xa9\xa9\x7a\x0e\xaa\xc0\xb5\xd4\x29\x81\xff\x68\x8d\xf8\x47\xe4\xda\x24\xbe\x60\x27\x12\x69\x88\x16\x5d\xa7\x00\x1e\x26\x2c\x58\x7b\x0f\x8f\x04\x1f\x9b\x49\xa4\xf6\x06\xf8\xf4\x1a\x70\x99\x9a\xaa\x28\xac\xf9\xbb\x35\xfe\xdd\xae\x83\xff\x43\x53\x46\x47\x1a\x6c\xbd\xeb\x64\x8c\x49\xc1\xd9\xe4\x47\xdf\x27\xe3\x51\x99\xe5\x23\xbe\xef\xf2\x87\x2b\x21\xc0\xd0\x77\x66\x7a\xab\x61\x24\xa9\x58\x78\x2d\x01\x6c\x93\x31\x91\xd6\x99\x3a\x19\x8f\xd7\x5c\x47\xf9\x12\x08\x26\xe3\x6a\xfa\x1e\xdb\xa5\x58\xb6\xf3\x32\xdb\xb4\x2d\xe1\x8a\x50\x4c\x0b\x7e\xc6\xbd\x75\xf9\x58\xc7\x0d\xa8\xab\xf8\x90\x40\x1b\x59\xeb\x12\xc1\xe0\xfd\x29\xe1\x61\x28\xf4\xeb\xa1\xcc\x8e\xb9\x52\x39\x53\x63\xdc\x49\x5b\xa4\x50\xbf\xb8\x19\x41\x60\x88\xa3\x2a\x79\xe7\x5a\xbe\xd6\x09\xd9\xbe\xda\x4b\x94\x4a\x50\x37\xd6\x2c\x81\xb0\x4e\xbb\xd5\x55\xbc\xb5\x16\xd8\x36\x95\xab\xc3\xee\x85\x52\xcb\x33\x2f\x69\xb8\x66\xc4\xfc\xba\x84\xfe\xe4\xc0\x27\x6e\x31\x8d\xb4\xf8\x94\x65\x4c\x9e\x51\xc5\x0e\x8f\xc8\x81\x4b\x41\x83\x45\x85\x3d\x84\xec\x82\x9a\x04\x22\x64\x06\x15\x66\x33\x70\x79\x19\x60\xdb\xda\x0f\xec\x16\xd5\x16\xdc\x2e\xaf\x77\x54\x45\x23\x95\x2f\xc1\xd1\x1c\x1e\xbf\x20\x7f\x3d\xda\x0b\x5b\x75\x95\x21\xae\xed\x4a\xbb\xda\xa0\xcd\x1e\xcc\xc6\xf5\xe6\x4b\x9a\x30\x83\xd9\xa1\x84\xe9\x1a\x45\x0c\x56\xcb\xde\xda\xd8\xae\x8f\x03\xc2\x57\xe4\xd0\x49\x9e\x4c\xa7\x64\x1b\x78\x1e\x91\xff\x00\x5f\x7d\x97\x05\x55\x25\x16\x59\xcd\xd9\xd9\xf5\xfc\x76\x7e\x76\xfa\xbe\x75\x67\x70\x40\xfe\x24\x2c\x56\xac\x4d\xcd\xc4\xc7\xfb\x53\xda\x50\x99\x9a\x29\xbe\x9b\xbf\x7d\x37\x80\x4c\x11\xc3\xef\x4f\x08\x03\x38\x58\xb1\x17\xe7\xf3\x4f\x1f\x06\xd0\x89\xc5\x66\x00\x91\xed\x96\x39\x7b\x7f\xf5\x5b\x27\x99\x83\xed\xba\xed\x35\x39\x17\x56\x35\xd5\x6a\xc3\x2c\xc3\xd7\x07\x01\x31\xe7\xe3\x00\xd6\x32\xc9\x6d\x74\xfa\xe1\xea\x7c\xfe\xe6\xf7\xdd\x22\xa8\x10\x9a\xa7\x0a\xc3\xdc\x01\x32\xc8\x83\x00\x2f\x70\xc0\x78\x2e\x4e\x6f\x2f\xf6\x26\x74\x0e\x71\x39\xac\x80\xe1\x46\x7a\x7e\xf1\xfe\x62\x00\x9d\x6b\x86\x57\xc2\xcf\x53\xea\xf5\xc5\xe5\xe9\x87\xfd\x49\x9d\x89\xec\xf1\x79\x84\xce\xae\x3e\xfe\xfe\x6c\xf3\xb1\xce\xb3\x69\x3c\xbf\xf1\x3b\x3e\x44\xb8\x12\x76\x9d\xdf\xe6\xff\x9a\xef\x9e\xed\x5b\xae\xf4\x50\xac\x6f\xe7\x37\xb7\xbb\x27\x57\x32\x7d\x15\x87\xe8\xf6\xdb\x14\xd0\x6b\x3b\x0a\x22\x0e\xdd\x86\x01\x8e\xb8\x00\x81\xf1\xe4\x27\x49\xa5\x7c\x5d\xb8\xf8\x7e\x52\x76\x07\x70\x9b\xf5\x5f\x10\xcd\x94\xe8\x88\xab\x11\x9e\xae\xa9\x86\x10\xc4\xed\x3f\xc5\xf6\x45\x67\x1d\x48\x91\xdf\x5b\xc9\xe9\x9a\xdd\x68\xaa\x73\x65\x9d\xaf\x48\x57\x5c\x82\xb7\x1a\x6c\xd8\x44\x1b\x5c\xbe\x32\xc8\xd0\x24\x2e\xdf\xcc\xaf\xc1\x81\xed\x56\x48\x8b\x03\x73\x85\xbc\x30\x9b\x13\xbf\x7f\x96\xdd\x37\x39\x79\x73\xfa\xfe\xe6\x82\x7c\xbc\xba\x81\xcd\xe1\xd7\x8b\x81\xec\x48\x86\xae\xdb\x5c\xbe\x0e\xf6\x29\x4d\x46\xae\x2f\xd0\x9f\x83\x93\x39\xdf\xd3\x9c\x4e\x15\xde\x54\x32\xd6\x41\xb9\xfb\x26\x92\xd0\x02\xc2\x9b\xfd\x03\x8d\xcb\x21\xb0\x41\x4c\xe3\x76\xaf\x7f\x7d\x5a\xea\xef\x05\xec\xc2\x0d\xd2\x4f\x84\x2e\x85\x1d\x26\xf0\x16\x8f\x54\x04\x21\xe0\x36\x94\x39\xec\x89\x5f\xbe\x41\x04\x53\xd5\xea\x00\xf6\xdd\x92\xda\x86\x60\x9f\x64\x0c\xe0\x45\x55\x49\x2a\xb0\xd4\x05\x18\x48\xe1\x04\xb9\x62\xb2\xf3\x1e\x75\x1f\x11\xd0\x8a\x18\xa2\xfd\xc4\x50\x65\x6d\x3b\xf1\x67\xb0\x37\x44\x9c\xb4\xc6\x60\xd5\x5e\xbe\x34\x44\x84\xf3\x78\x88\xa9\xc5\x8e\xd3\x95\xe9\xf1\xf1\xc0\x57\x2f\xba\x88\x7e\xa9\x8f\xb0\xb9\x75\x33\xa3\xf3\x6d\xf9\x95\xe1\x3b\xfa\xa5\x5d\xe4\xd2\x99\x49\x08\x62\x81\xe5\x2a\xa6\xb6\x25\xe4\x2a\xe1\x25\xfa\x7a\x0d\xcb\x99\x19\xd7\x5e\xfa\x66\x4c\x04\xc7\x27\x96\xc2\x1c\x25\xa6\xf4\x7f\xd2\x3c\x61\xea\xf5\x80\xaa\x95\xae\xe9\x37\xee\x17\x8a\x05\x69\x0c\x8b\xab\x5b\xa6\xf4\x35\x43\x71\x86\x87\x47\x6d\xd7\xd0\x93\x11\x74\x91\x6b\x2d\x1b\x08\xe7\x22\x38\xca\xa5\xeb\xd9\xa5\x80\x98\x9b\x9d\x00\xdb\xf6\x9d\xdc\x02\x2d\x82\x17\xb4\x24\x16\xe2\x0e\x5c\x99\x20\x4b\x06\x87\x5c\x65\x4a\xd9\xa4\x25\xdf\x2a\x90\x68\xba\xb1\x0a\x2f\x98\xaa\x59\x4b\x91\x67\xa4\x7c\x6a\x66\xd4\x9f\xce\x00\x55\x12\x3b\x0b\xac\xe7\x5b\x48\xba\x69\xe7\x85\x2a\x01\x10\xdd\xb4\x53\x3d\x7b\x23\x8f\xd8\x43\x98\x27\xd9\x2e\x02\xef\xd8\x03\xc1\x31\x03\x13\x4a\x05\x19\x1f\x4b\xfe\x7c\xd3\xd3\x48\x11\xc9\x66\x7e\x28\x32\xe9\x9a\x93\x8e\x6c\x09\x38\xbd\xc2\x7f\x15\xba\xeb\x5e\xe4\xa5\x6a\xc7\xdd\xe3\xca\x55\x5f\x0e\x7b\xf2\x1c\xdb\x99\xac\xd9\x1d\x08\xf5\xa5\xba\x0c\x33\xcd\x50\x94\xfc\xdd\x3c\x70\xcc\x91\x4b\x4c\x68\x9c\xe0\x41\xe2\xbe\x7c\x3d\x98\x9d\x74\xa7\xa0\xbe\xa1\x44\xb6\xe1\x5a\x8f\x40\x3a\x45\xf2\x67\x63\x8d\x16\x1b\x2c\x4f\xd9\x65\x9e\x2c\x81\x9b\x19\x39\xde\x5f\x56\x08\x47\x90\x99\x0a\x82\xa7\x65\x81\x19\x8e\x94\x67\x19\xd3\x5f\x85\x79\x77\xec\xdc\x9f\x6d\x07\xd1\xcf\x68\x25\x09\x83\xf1\xf0\xa1\xdd\x88\x21\x22\x05\x07\x1b\xa0\x2e\x48\x50\xbe\x1d\x55\x12\x57\x38\x0c\xfc\xd1\x1a\x94\x66\xe2\xe8\xb2\x63\x3b\x73\x08\x83\x20\x08\x93\x6c\x7e\xfe\x55\x66\xff\xe3\x02\x1c\xf1\x45\x92\xe9\xc7\xc3\x6b\xb3\xd9\x02\x47\xea\x68\x7f\x59\x6c\x81\x7a\xa5\xd1\xbe\x0f\x3e\x20\x8b\x11\xa3\x41\x54\x21\xf9\x82\xac\xf2\xd4\x2c\x9b\x43\xe9\x1a\x3b\xb8\x68\x64\x23\x51\x22\xe5\xf0\xfe\x08\xa2\x37\x84\xa8\xc2\x9a\x30\xa1\x5e\x28\x56\x95\xde\xd1\xeb\x36\x2f\xcf\x92\x7b\x97\x4b\x3c\x35\x65\xd2\x7d\x4e\xb1\x8c\xe2\xec\xb0\x46\x84\xb8\xc3\x79\xd5\x95\x3b\x50\xad\xcf\x55\x68\x43\x95\xfd\x4a\x2c\x6d\x1a\x46\x6d\x6d\xf9\x7f\x2f\xfc\x0f\x70\xc2\x81\xd3\x4d\xb7\xf4\xb7\x97\xbf\xa9\xf6\xb9\xa6\x31\x0f\x2a\xc1\x31\x84\x4a\x69\x80\x01\x84\xd5\x4f\x81\xa9\x88\x8e\x9f\x50\x51\x17\x2b\xf3\xf3\x1e\x1b\xf8\xa1\x4f\x76\xf3\xb0\x57\x74\x2e\x38\xa8\x86\x03\x3c\x5c\x04\x31\xcf\x96\x82\xca\xb0\x15\x0e\xb4\x2e\xa0\x8a\xdb\xa9\x22\xb0\x2c\x01\x4d\x05\x87\x5d\x7c\x86\x7c\x23\x2b\x2d\x38\x11\x7c\x3b\xda\xdb\xa6\x9d\xdb\x01\x4c\x5b\x8d\x75\x39\xb5\xef\xf1\xa2\x7a\x29\x27\x66\x0a\x6a\x33\xb4\x47\xd5\x05\xb6\x7b\xb5\x9b\x7b\x5b\x56\xfe\xec\x8a\x89\x44\xfa\x3f\x7b\x5d\x94\xdc\xa1\x78\x67\xb9\x04\x41\x93\x69\x9d\xc9\x4d\x60\x60\x79\xc0\xfb\xc4\x13\x6c\x39\x98\xcd\x3e\xa5\x16\x75\xb8\x5f\x7d\x43\x99\xe9\xe8\x26\x52\xe9\xef\xa4\x76\xe6\xfa\xf7\xa2\xd6\xc8\x6a\x74\x93\x6c\x0e\xea\xa4\xfb\x06\x07\x11\x37\x68\xcf\x9a\x83\x32\x87\xd1\x4d\xb8\x3a\xa0\x93\xe8\x75\x39\x60\xef\x22\x10\x5b\xd1\x53\x2d\xd0\x79\x9e\xb9\x94\x19\x8d\x7a\x81\xcf\x69\xd9\x9c\xd0\x87\x98\xa5\x6b\xfc\x36\xc2\x14\xb5\x17\x93\x6e\xa4\x3f\xbc\xfa\x06\x55\x3f\x01\xa8\x7c\x69\xca\xb6\xfb\xe2\x7c\x77\xdd\xdc\x6f\xcc\x14\xf4\x35\xbb\xa1\xf7\xac\xf3\xb8\x61\xb3\x35\x1d\x70\x89\xf5\x7c\x1d\x95\xe1\xe8\xbf\x40\x6a\x54\x32\xba\x97\xe0\x36\x3e\x4c\x9e\x24\xba\xc9\x60\x2a\xe0\xcc\x86\x87\xbe\x0d\x60\xf8\xb9\x21\xc4\x4b\xdb\x59\x97\x20\x96\xfc\xa3\xf0\x4c\xa7\xf5\xc8\x05\x23\x55\xff\x82\xf4\xfb\xdd\x4b\x77\xd5\x80\xe5\xcc\x9c\x70\x4d\xed\xef\x42\x65\x3c\x85\x78\xa3\xf3\x2b\x8b\xe2\x9b\x98\x02\x4b\x31\xd2\xab\x7f\x23\x53\xb4\x8e\xd6\x7c\x55\x7c\xf1\x82\x37\xfc\x78\x40\x35\x27\xd7\xe2\xeb\x29\x55\x96\x81\xb5\x49\x37\xcd\x22\x9b\xf5\x61\xa8\x55\x28\x74\x7d\xf4\xd0\x20\xe0\x40\xfb\x26\x97\x49\xd6\x07\x62\xca\x21\x24\x7b\x6a\xb8\x3b\x9e\x36\x47\x77\x15\xef\xf5\x25\x1a\xec\x05\x6c\xff\x27\x39\x95\xd4\x62\x65\x2b\xb7\xcf\x1b\xf3\xa9\x4b\x79\x83\xda\xde\xcb\x4c\xcf\x32\x8f\x97\xe5\x5e\x46\x6e\x79\x76\x42\xfe\x89\xd6\xc8\xca\xba\x27\xbc\xcc\xce\x95\xfb\x42\xae\x03\x0f\x95\x00\xe0\xc7\x6c\xa5\xb7\x88\xb0\x98\xad\x77\x68\x91\x4e\x28\xc7\x62\x23\xb9\x63\x8f\x6a\xd4\xcc\xcb\x6c\x63\xbe\xae\x6c\x67\x2b\x68\xc6\xf3\xf0\xce\xa4\x5b\x57\xc8\xdc\x74\x2b\xee\x6a\xab\xc8\xc4\x14\xe9\x87\xd9\xaf\xdc\x54\xa3\xc5\xac\xf6\x91\x56\x8b\x85\x3d\x12\x93\xfb\x30\x51\xa9\x96\xe9\x60\x23\x28\xea\x3b\x68\x7f\x5c\x58\xbb\xc7\xaf\x67\x03\x9b\x56\x86\xcc\x2c\x41\xd9\xec\x61\xea\xf9\x2f\x1d\x41\xd8\x4f\x62\xb1\xae\x7b\xab\xa7\xd2\x82\x16\x86\xd8\x97\xb8\x4c\x66\x85\x22\xc8\x13\x96\xea\x9e\x8f\xb4\xec\xf0\x62\x75\x95\xf5\x6e\x1d\xd3\xd8\xd6\xc1\xb9\x8c\xa6\x75\x37\x9f\xe9\x3d\xb5\x0d\x6a\xfc\xf9\x8f\x9c\xc9\x47\xff\xd5\xe8\xd5\xe8\xe5\xe8\xb3\x59\xab\x6e\xf6\xbb\x01\x73\x10\x80\x54\x01\xa8\x68\x10\xd8\x92\x06\x77\x4b\x91\x0e\x03\xca\x04\x16\x30\x0c\xa3\x53\x7e\x04\x3a\x04\xaa\x0c\x57\x07\x41\x15\x9e\x6b\x10\x4c\xf5\x4b\xcf\x26\x1c\x6c\xba\xa6\xf6\x67\x32\xb6\xdf\x0b\xff\x17\x2d\x84\xe2\x6c\x40\x3c\x00\x00")
 
 func staticIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -288,7 +288,7 @@ func staticIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/index.html", size: 8726, mode: os.FileMode(420), modTime: time.Unix(1528532867, 0)}
+	info := bindataFileInfo{name: "static/index.html", size: 15424, mode: os.FileMode(420), modTime: time.Unix(1528532867, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -313,7 +313,7 @@ func staticJavascriptsDs_store() (*asset, error) {
 	return a, nil
 }
 
-var _staticJavascriptsApplicationJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\xef\x72\xdb\x38\x92\xff\x9e\xa7\xc0\x62\xbc\x67\x32\xa6\x28\xd9\x77\x99\x9d\xa1\xa3\xe4\x3c\xf9\xeb\xab\x99\x4c\x2a\xc9\xdc\x55\x9d\xed\xf5\x41\x64\x4b\xc2\x98\x02\x59\x00\x64\xc9\x89\x75\xb5\x4f\xb3\x0f\xb6\x4f\x72\x05\x10\x20\x01\x92\x92\xe5\xf9\x72\x3b\x35\xe5\x88\x40\xf7\xaf\x1b\x0d\xa0\xff\x91\xb7\x84\xa3\xcf\x92\x48\x81\xc6\xe8\x27\x92\xde\x4c\x0a\x06\xf1\x2f\x45\x06\x79\x0c\x6b\x09\x2c\x0b\xbe\x3d\x41\x68\xc9\xf3\x04\xe1\xa1\x50\x84\x38\x7a\x82\x50\x06\x53\xb2\xcc\xa5\x48\x90\x9a\x46\x08\x2b\x8c\xa5\xc0\x09\x32\xff\x61\xca\xa8\xa4\x24\xa7\x5f\x29\x9b\x69\x96\x8a\x88\x4b\xc8\xce\xa4\xa1\x63\xcb\x3c\x37\x53\x6f\x29\xa3\x62\xde\xcc\x39\x53\x1f\x79\x31\xe3\x20\x6a\xf0\x91\x19\xff\x42\xf8\x0c\x64\x23\xd3\x8e\x7f\x82\xb2\x10\x54\x16\x9c\x82\x9e\xb4\xe3\xaf\x8a\xc5\x82\xf6\xd0\xbf\xa5\x39\x38\x9a\x3b\xe3\x2c\xa3\x6c\xe6\xcb\xdd\xa8\x3f\x54\x58\x75\x13\x34\x5d\xb2\x54\xd2\x82\x05\xa1\x31\x05\x07\xb9\xe4\x0c\xc9\x39\x15\xf1\x0c\x64\x60\x4d\x13\xa2\xf1\x78\x8c\xf0\xd4\x70\xe2\x53\x8b\x96\x2d\x39\x51\x08\x3d\x58\x74\x8a\x02\x0f\xc8\x98\xaf\xc2\x52\x36\xb2\x94\xb5\x5c\x3c\x1a\x25\xfa\x7f\x2d\x00\xa1\x8d\xfe\xab\xb6\x19\x58\x76\x5a\x3f\x08\x85\x85\xc6\xe8\x35\x91\x10\x97\x84\x0b\xe8\x17\x14\x9e\xfa\x8a\x34\x4b\x0f\xc2\x46\x36\xb0\x6c\x1b\x96\xb3\xb1\x16\x6c\x83\x20\x17\xd0\xc7\xcc\x8a\x55\x10\xb6\xf5\x5e\xd0\x3c\xa7\x02\x21\x34\xd6\xa4\x83\x4a\x77\x67\x29\x90\x16\x2c\x13\x6a\xfe\x17\x22\xe7\xf1\x34\x2f\x0a\x1e\x18\xae\x21\x3a\x1e\x8d\x46\x61\x43\xad\x8c\xa6\x64\xa1\x31\x62\xb0\xd2\x62\x03\x6d\xc8\x8a\xc4\x4e\xc7\x02\xe4\xe7\x0a\x38\x30\x02\x0c\x85\xb1\x73\x4d\x28\x8b\xf3\xcf\xbf\x7e\x96\x9c\xb2\x59\x10\xc6\x62\x39\x11\x92\x07\xc7\xc7\x11\xfa\x21\x34\x5b\xbc\x09\x4f\x9f\xac\x28\xcb\x8a\x55\x2c\xcc\x55\x53\xa2\xf5\xb5\x3b\x7d\xf2\x44\x69\x65\xce\xda\xce\x4b\x48\xb3\x33\x29\x39\x9d\x2c\x25\x24\x08\x9f\x67\xfa\x56\x49\x10\x52\x1d\xe0\x73\x96\xd1\x94\xc8\x82\x8b\x04\x5d\x60\x35\x8a\x23\x84\xaf\x45\x09\xa9\xfa\x31\xa5\x6b\xb9\xe4\xa0\x7e\x2e\x8a\xf4\x46\xfd\x2b\xe4\x72\xa2\xa7\xc8\x8d\x1e\xcf\x60\x51\xe8\x71\xb2\x28\x73\xc0\x57\x0a\x5d\xcc\x0b\x2e\xab\x7b\xf3\x9e\x88\xf9\x3e\xa7\xbd\xa1\xc6\xb5\x35\x46\x11\xfa\x4b\x58\x9f\x77\xc9\xe9\x62\x01\x59\x45\xf8\x0b\x08\x41\x66\xd0\x83\xac\xb7\xbe\x9a\x45\xe3\x8e\x00\xc3\xa7\x64\x94\x39\x95\x01\x1e\xa8\xff\xde\x7c\x78\x8d\x3e\xbe\xfb\x88\x3e\x9f\xbf\xfb\x70\xf6\xe5\xb7\x4f\x6f\xf4\x28\x8e\xd0\x49\x18\x97\x45\x19\xf8\x5b\x68\xd0\x63\x0e\x65\x4e\x52\x08\x86\x7f\xbd\x14\x97\xe2\xe9\x30\x42\x18\x87\xcd\xa8\x1e\x3c\xa8\x46\x1b\x0f\xf0\x05\x84\xfc\x04\x39\x91\xbd\x4e\x40\x29\x5f\x12\x39\xf7\x34\x57\xfb\xf4\x91\x48\x65\x18\x59\xfc\x5c\xac\x80\xbf\x22\x02\xac\x52\xd3\x82\xa3\x40\xf1\x51\x34\x46\xa3\x53\x44\xd1\xf3\x8a\xb7\xbb\xc5\x71\x0e\x6c\x26\xe7\xa7\x88\x1e\x1d\x35\x97\x50\xdd\x51\x25\x33\xa6\x2c\x83\xf5\xaf\xd3\x60\x0b\xf7\x05\xbd\x0a\xd1\x0b\x34\x38\x6e\x58\x9b\x7d\xe4\x4b\x38\x35\x83\x1b\xe7\x1e\x9a\xe9\x29\xc9\x05\xd4\x1b\x39\xa5\x39\xbc\x2a\x98\x04\x26\xc5\x6f\x2a\x42\x6c\x3b\x1d\x17\x78\x38\xd5\x4e\x36\x72\xac\x51\xbb\xe9\xbb\x5f\x57\x0c\x38\x0e\xfb\x27\x3f\x90\x05\xf8\x73\xee\x09\x8b\x7a\xcd\x7b\x15\xff\x5e\x50\x16\xe0\x21\x0e\x7b\x95\x75\x34\x4d\x49\x9e\x4f\x48\x7a\x13\x21\xe0\xbc\xe0\x56\xf1\x83\x98\xfc\x4e\xd6\x81\xb5\x8f\x8e\x7f\x5a\x52\x6b\xcd\x41\x18\x19\x12\xb1\x4c\x53\x10\x22\x41\x35\xa2\x75\x6f\x0a\x37\xa9\xfe\xa9\x2c\xea\xfa\x05\xf7\xf6\x7b\x31\xf8\x55\x91\xe7\xa0\x75\xec\x09\xc4\x53\x1b\x9a\x94\x90\x85\x72\x14\x89\x05\x31\xb0\xc6\xdf\x4c\x1b\x64\xe5\x72\xac\xa0\xc0\x4a\xd6\x3e\xe8\x3f\x29\xac\x5c\xd1\xea\xd9\x77\x3c\x89\x72\x17\x44\x8a\xeb\xb4\x60\x92\x50\xb5\x5d\x8e\x64\x3d\xa5\x9e\xcb\x22\xcf\x29\x9b\x7d\xa1\xe9\x0d\xf0\xa4\x8e\xe1\x36\xc0\xb5\xc7\x0d\xf9\x39\x93\xc0\x6f\x49\x9e\xa0\x67\x23\x1d\x63\xeb\xd4\xa1\xcf\x2d\xe8\x5d\xc8\xa9\x90\xc0\xbe\x14\xd5\x11\xd7\x6a\x44\x08\xa7\x73\xc2\x66\x60\x0f\x19\x07\x96\x01\x0f\x1b\x26\x1d\x36\x5e\x7b\xba\xd8\xbb\xd7\xcc\x7f\xac\x74\x0a\x9a\x83\x53\xe1\xec\x0a\xce\x5a\xfe\x96\xc8\x68\x90\x8b\xd2\x03\xf6\x66\xfa\x55\xda\xf4\xc9\x98\x13\xf1\x4a\x2f\x32\x0b\x9a\xb4\xa8\x2d\x6d\x59\x66\x44\x82\x9d\xde\x1b\xaf\x4e\x77\xfa\xf1\xdc\xa3\xb3\x27\x9e\xba\xf1\xdb\xc0\x72\xd8\x1f\xc9\x26\x6e\xfd\x58\x66\x76\x6f\x34\x2f\x3d\xec\x87\x74\x49\xf6\xc6\xb5\xe9\x68\x3f\xa4\x99\x75\xd1\xf4\xe9\x72\x0f\xdd\xb6\xd3\xee\x5d\x2b\x34\x46\x02\xa4\xbd\x33\x41\x87\x03\x55\xd7\x51\xdf\xe1\x4a\xc9\x29\xc8\x74\x5e\x0b\x8e\x3c\x4c\x8b\xd3\x1c\x77\xe7\xac\xee\x3a\xf3\xbe\x4e\x7f\xea\x64\xa3\x69\x0e\x84\xd7\x5a\x76\x59\x7a\xed\xf0\xba\xe5\x28\xfa\xcd\xe1\x53\x3d\xc6\x1e\xd5\x56\x58\xfe\x20\xb4\x16\xa9\x53\xc4\xda\x02\xfb\x69\xd2\xc6\x6b\xe5\xca\xbe\xdf\xdb\xcf\x48\x3e\x4f\xdb\x4a\xbe\xc0\x1e\xb5\x0e\x02\xfc\x5d\x4a\x78\x76\x6d\x71\xae\x6f\x49\xbe\x54\x69\x92\x84\xb5\x74\x8f\x6e\x56\x6b\xdd\xac\xdc\xf7\x1c\x5b\xd2\x1a\xa1\xcb\x19\x9b\xd8\x54\x4f\x5f\x8a\xf7\xcb\x05\xa9\x2d\x70\x10\x60\x49\x65\x5e\x8b\xc5\xef\xa8\xe4\xc5\x24\x41\x18\x1d\x19\xfe\x86\xf2\xbb\xd2\xc8\xbb\x9e\x10\x6e\x39\x0c\x51\x9c\x0a\x11\xe0\x15\xcd\xe4\xdc\xba\xf5\x4a\x7b\x1d\xf0\x1b\x0f\x88\x8e\x10\xfe\x33\x6e\xdb\x7f\x97\x5f\xee\x11\xcc\x61\x51\xdc\xc2\xab\x9c\x28\x99\x76\x6e\x30\x21\x7c\x40\x18\x5d\xa8\x44\x0f\x79\xa3\x42\x72\x5a\x42\x86\x5b\x5a\xe2\xe3\xd1\xa8\xd6\xa5\xb5\x73\xd6\x89\xee\xda\x39\x1b\xb2\xeb\x9d\x9b\xd3\x0c\x82\xee\x06\xda\xea\xca\x38\x6d\x9d\x52\xa6\x24\x07\x5b\x8a\x84\xf1\x94\x64\x70\xce\x02\x3c\x25\x42\xe2\xf6\x2e\x6b\x17\xbc\x5b\x8f\x1c\xf6\x55\x42\x7b\xfa\xc7\x6a\x60\x1c\xf7\x2e\x1d\xd2\x8a\x64\x2f\x2d\xea\x28\xf1\x58\x3d\x5c\x6f\xbf\x4b\x19\xee\xd0\xed\xa5\x91\x1f\x69\x1e\xab\x96\x89\x18\xbb\x34\x92\x15\xc9\x5e\xca\xd4\xe1\x69\x7f\x3d\xbc\xbb\xbd\xd3\x1b\x54\x87\x5d\xac\xa8\x8a\x34\x6d\xc9\xb6\xff\x61\xd9\x52\x22\xa0\xd5\x1f\x4a\x1c\x57\xad\x7d\x0b\x3e\x77\xa7\x6d\xca\x34\xe1\x40\x6e\x4e\x1d\x90\x19\x91\x73\xe0\xfd\x08\xef\xec\x1c\x72\x37\x6e\x3b\x16\x61\x24\xbf\xdb\xa2\xcd\x99\x9d\xf3\xb1\xb6\x41\xd5\x3d\x9e\x2e\xd2\x5b\xb7\xfd\xd3\x62\x36\x4d\xb5\x2e\xd3\x6f\xec\x86\x15\x2b\xd6\xc7\xe3\x95\x67\x86\xe3\x08\x61\x14\x28\x57\xab\x7b\x31\xe7\xac\x7b\x18\xdc\xdc\x51\xb9\xce\xb0\xea\x46\x75\x3a\x15\xa6\x32\xa8\xbb\x15\xea\x39\xf8\xa6\x72\x7e\x75\x06\xdb\x25\x41\xd8\x2e\x68\x1e\x2a\x2c\x24\x99\xa9\xf2\x2e\x41\x58\x56\x05\x05\xdc\x56\xe5\x99\x69\x2a\xa6\x39\x4d\x6f\x90\xcc\xe2\xb4\xc8\x07\xba\x9c\x26\x58\x95\x22\xf3\x62\x65\x24\xe0\xba\x27\x27\x61\x51\xaa\x6a\x3c\x41\xd7\xb1\xfd\x1d\x28\x2d\xed\x83\x75\xac\xea\x9e\xc8\x45\x1e\x84\xe1\xce\xec\x5e\x9b\xec\x40\xe5\x78\x8a\xd8\x94\xd2\x06\xd6\x31\x27\xb1\x2d\x19\x11\x86\x71\x46\x24\x09\xb0\x95\xe3\x06\xac\x6d\xa1\xc9\xe9\x22\x74\xaa\x06\x25\x9c\x64\x99\x09\x48\xaa\x8e\x1f\xf0\x8a\x14\x87\x3d\x9b\xaf\x78\x9a\x6a\xb7\xe0\x0b\x22\x25\x64\xb6\x28\xde\x76\x7d\xcb\x9c\x4a\x81\x74\x73\xad\xcf\xad\x9b\x76\x85\xe9\xb1\x0c\xb1\xd3\x48\x53\xf1\x81\x91\x05\x28\xd6\x0a\xc6\x6d\xb1\x28\x8a\x8c\x72\x48\x55\x0d\x6f\xc1\x21\xcf\x69\x29\xa8\xa0\x5f\x21\x30\x2c\x75\xa1\x1e\xa1\xef\x47\x11\x3a\x79\xe6\x58\xca\xe1\x1f\x8f\x11\xc6\xdd\x5e\xe7\x73\x21\x79\xc1\x66\x2f\xd4\x61\xbf\x8e\x41\xa4\xa4\x84\xc0\x2a\xa6\x8f\xf6\xf3\xa1\x25\xe9\x31\x59\xcd\x52\x4b\xd2\x3c\x43\xac\x39\x1f\x89\xad\xed\xee\xac\xd0\xb1\xb8\x90\x3c\x42\x0b\xca\x7e\xd6\x6d\x9b\x08\x41\x36\x83\xea\xb7\x5d\x92\x90\x2a\x91\x35\x1e\x59\x48\xee\x58\x41\x48\x6e\xfa\x3d\xe8\x79\x03\x82\xee\xef\x91\x3b\x33\x46\x41\x83\x8a\x9e\xa2\x93\xb0\x63\x2d\x21\x79\xa7\x25\x9c\xcd\xa0\xa2\x19\xa3\x33\xce\xc9\x9d\x0b\x72\x84\x8e\x43\xb3\x3f\xb1\xbb\xf1\x0b\x9a\x19\x8a\xb1\xab\xc2\x00\xf9\x0a\x9c\xba\x8d\x30\x09\x9c\x69\x29\x58\x3b\x26\x2d\xf7\x08\xe1\x30\xfe\xa6\x1e\x1b\xc4\x23\x84\x37\x3e\x05\x3e\xf5\x3d\x1c\xaf\x1b\x73\xca\x2b\x7d\x82\xd9\x9b\x75\x19\x18\x09\x61\x84\xf0\xc1\xf1\x3f\xfe\xf6\xf7\x83\x13\x37\x8c\x35\xee\xc2\xd9\x13\xb0\xf6\x81\xb8\xe4\xda\xef\xbc\xae\xdc\xaf\xd7\x13\x58\x10\x7e\x73\x26\x3e\x43\x0e\xa9\xbe\xa2\x8e\x15\x8a\x8c\xe4\x8e\x7f\x34\x12\x7e\x51\xc3\x75\xdf\xc8\x34\x48\x9c\x2e\x85\x6d\x0a\xe5\x09\xc2\xdf\x19\x4f\x71\xad\xb1\x50\xac\xff\x19\xa4\x55\x77\x09\x3b\xbd\x22\xd4\x48\x33\x6d\x0d\x27\xd3\xf6\x51\x70\x58\xc1\x04\x1d\x46\x5d\x06\xbe\x75\xda\x57\x4e\x8f\xc3\x5f\xe6\x2e\x6f\x98\xe6\x85\x00\x21\x03\x2c\x27\x45\x76\x87\x43\xdd\x61\x0a\xb0\xe4\xb1\x24\x93\x1c\x06\xc2\x60\xb4\xf3\xe9\xf6\xec\xe9\x93\x6d\x7e\xae\x87\xb0\xaf\x57\xf6\x50\x6c\x49\xeb\xfe\x59\x82\x6c\x4a\xfd\x47\x9a\x4b\x0d\x4e\x84\x30\xc9\x32\xbf\xbd\x64\xb4\x71\x97\x53\xb3\x57\x6d\x31\xdb\x96\x4a\xea\x54\x3d\x42\xd7\x71\x06\x93\x62\xc9\x52\x13\x4a\xaa\x84\x2f\x42\xcf\x46\xa3\xb0\xbb\xb1\xe2\x5a\x00\xe1\xa9\xf2\xc3\x05\x0b\xf0\x0d\xdc\x2d\xcb\x1e\x90\x8a\xc8\x4a\x89\xd0\x49\x2f\x58\x7d\x4a\x14\x94\xba\x19\xf1\x44\x54\x27\x06\x47\xce\xe5\x50\xf7\xc1\x2d\x96\xb2\x22\x5d\x2e\xd4\x98\x55\x21\x53\xf9\x48\xd4\x73\x9d\x9c\x44\x10\xe2\x1b\xb8\x7b\x55\x64\xde\x9c\xce\x90\xfe\xf5\x2f\x49\x3d\x60\xa3\x89\x7d\x01\x32\x75\x36\x58\x5f\x4d\x5a\x2c\x85\x59\x56\xd3\x3d\x6b\xa5\x41\x0d\xf2\x8f\x7b\x22\x33\x58\xcb\x7d\x50\x5b\x49\x59\xe3\x8b\x1a\x92\x4d\xfd\x4b\xf9\x6b\x23\xc5\xba\x45\x15\xba\x46\xae\x01\x76\xf1\x7b\x1a\x92\x54\xd2\x5b\xa8\x75\xdc\xe7\x3e\x39\x18\x0f\x5c\xa9\xc6\x3e\x7b\x39\x32\xc7\x99\x59\x7c\x3f\xd9\xa9\xfb\xe0\x8f\xf1\x6e\xae\x87\xdb\xe5\xe5\xb6\x9d\x61\xcf\xd3\xa1\x7d\xbc\x9d\x2b\x71\x53\xf5\x7f\xf4\x89\x9e\xd3\x2c\x03\xf6\xd8\xbb\xb0\x64\x13\xed\xfd\xec\x7d\xa8\x81\x5b\xa5\xdc\x36\x4f\xd3\xf8\x16\xb7\x49\xe7\x74\x9d\xbb\x61\xcb\x98\xc0\xcd\xe1\xcc\xd0\x9b\xdc\xdf\xc0\x2a\x57\xf7\x37\x6d\x13\xd6\x96\x8d\x21\xb7\xce\xa1\x06\x08\x63\x52\x96\xc0\x32\xeb\xfb\x0e\xc0\x69\x0c\x7a\xc7\xf1\x81\x37\x81\xca\xa5\x6f\x0d\x0c\x35\xa2\x73\x05\x1f\xc0\x6b\x5f\x05\xc5\x79\x96\xe7\x0a\x1e\x87\x31\x2b\x64\x80\xe3\x6c\xc0\x0a\x06\x3a\x22\x71\x21\x1d\x53\xb6\x7c\xc8\x23\x45\x29\xee\xbd\x45\xf9\x3e\x78\x4b\xce\xcd\x00\xb2\x1c\xd0\x18\x1d\xc4\x92\xd3\x45\xd0\xef\xea\x6f\xd5\xc9\xee\x7d\x51\xa8\x9c\x8c\xc5\xf0\xd3\x62\x5d\xee\x28\x6b\xd7\x4d\x24\xa4\xc3\x34\x92\x9d\xfe\x96\x5d\xc2\xe9\x93\xae\x4f\xda\x3c\x79\x18\x0c\x48\x3a\xef\x6b\xb6\x3a\x2f\x3e\x0f\xf4\x21\xaa\x33\x84\xa6\x8a\xb3\x4d\xbe\xde\xd5\x55\x10\x55\xe3\x67\x1b\x48\x35\xbb\x07\x4c\x5d\xad\xdf\x6d\x83\x6a\x28\x1e\x80\xeb\xbc\x5d\xad\xb6\xa0\x7a\x93\xaa\xb2\xf1\x4a\xa9\xad\xd3\x8d\xa0\x5e\x12\x37\x42\x58\x45\x77\x6e\x58\xeb\x23\x8a\x86\xab\xf1\xfb\x1d\x16\xd7\x3b\xb9\xb5\xfe\xd4\xcf\xa9\xdc\x37\x85\x4d\xc5\xdf\x7f\x1a\x70\x3b\x31\xd3\x71\x63\x67\xd1\xbf\x6f\xa1\x5e\xbb\x79\xa7\x5c\xa7\x4c\x02\x07\x21\x29\x9b\x55\xc5\xd2\xc7\x2a\xf3\x17\x09\xba\xd0\xab\x1b\x06\xc1\xc9\xb3\x8b\xd1\xe0\xd9\xd5\xfd\xc9\xc5\x68\xf0\x6f\x57\x17\xa3\xc1\x8f\x57\xf7\x17\xa3\xe3\xab\x97\xfa\xa7\xfe\xf3\x32\xbc\x8c\xff\x7f\xe8\xc2\xe1\x6c\x41\x23\xa3\xea\x05\x19\x7c\x3d\x1b\xfc\xf7\x68\xf0\x63\xfc\xa7\xef\x0e\xfe\xfc\x2f\x4f\x8f\x86\xe3\x97\x7f\xbd\xfe\x9f\x6f\xf7\x9b\xff\x1d\x5c\x1d\xfd\x7b\x33\x7f\x15\xbc\x4c\x9a\xa7\xc1\xd5\xb7\x51\xf4\xfd\xf1\xc6\x99\x0f\x5f\x06\x2f\x93\xcb\xf8\x51\x1c\xe1\x53\x4f\x9b\xe0\x72\xf5\x34\xb9\x1c\x5e\x0e\xc3\xe0\xe2\x32\x23\x83\xaf\x97\xf1\xe0\xea\x48\xad\xec\x42\x3f\x5c\x7d\x3b\x89\xbe\xdf\x74\x56\x30\x1d\x0d\x7e\xbc\x1c\x5c\x1e\x5c\x0e\xaf\xbe\x9d\x8c\xa2\x8d\x37\xbf\x14\xc0\x75\xbd\xec\x0e\x0a\x48\x39\x48\x6f\xa8\x24\x42\xac\x82\x82\x87\x2f\x33\x6f\x3c\xe5\x90\x05\xe2\x1e\x98\xca\xd9\x7d\xd1\x44\xbf\x6f\x0f\xae\xef\x07\xf7\x71\xf8\x52\x16\x37\xc0\xea\xf9\xab\xad\xcd\xa4\x3a\x87\xb8\xa5\xb0\xba\xe6\x64\x65\x1b\x4a\x9f\xc8\xca\xa6\x0a\xf6\x73\xb5\x3e\x8e\x39\xac\xb3\xe5\xa2\xb4\x5c\xef\x61\xfd\x7a\xb9\x28\x3d\xce\xdd\x6f\x8d\xff\x40\x5f\xc9\x7c\x99\x04\x2b\xf4\x2a\xa7\xe5\xa4\x20\x3c\xfb\x8f\xcf\xc1\x61\x3c\x91\xec\x30\x6a\x5e\x26\xd9\x3e\x5c\x82\x6c\x86\x12\xcf\x40\xbe\xc9\x41\xfd\xfc\xe9\xee\x3c\x0b\x0e\xbd\x9b\x75\x18\x7a\x25\x66\x5f\x1b\xa9\x65\x98\x2d\xbd\xe8\x8e\x49\x5d\x27\x54\xc5\x53\xdc\x53\x89\x78\xf6\x6c\x79\xbb\x2e\x97\x56\x59\xbf\x94\x70\x78\xaa\x86\x77\x2f\x51\x6a\xb7\x24\x8c\xd5\x2a\x02\xbf\x1f\xd0\xda\xb7\xfd\x17\xf6\x80\x96\x5b\xd6\xb6\xcb\x1c\xfd\x3a\xef\x58\x59\x03\xdb\x5a\x98\xe4\x4b\x96\x12\xf9\x87\x3e\xad\xaa\x4e\x5d\xdf\xa7\x59\x6e\xda\x61\xbf\x98\x6a\xba\x4e\xc7\xcf\x46\x9d\x46\x53\xdd\x2d\x33\xe4\x7d\xdd\xca\x36\x8d\xf3\xa9\x98\x82\xd4\xfd\xb5\x7f\xfc\xed\xef\x4d\x67\xed\xa1\x2f\xae\xdc\x1c\xae\xb7\xbb\xea\x20\xfd\x44\x19\xe1\x77\x0e\x88\xaa\x65\x5a\x40\xc3\x8b\xcb\xf5\x68\x34\xb8\x5c\x8f\x7e\xb8\x5c\x8f\xde\x0c\x2e\xd7\xc7\x6f\xaf\x86\xfa\x73\xaa\x8a\xbc\xc6\x9b\xd3\xd9\x3c\xa7\xb3\x79\xf5\x12\xda\x0d\x4e\xee\xb9\x9a\x93\x3b\x21\x49\x7a\xe3\xf9\x81\xad\xe1\x2c\x9e\x16\xfc\x8d\x97\x62\xd9\x16\x57\x6d\x6c\x0b\x88\xc6\xf5\xcf\xba\x35\x66\x88\x23\x84\x9f\x2f\x08\xbf\x79\x71\x70\xfc\x7c\xa8\x7f\xf8\x25\x4a\xbd\x58\x0b\xd0\xf4\x8f\xdb\xe5\xd3\xc3\xdf\xbf\xe8\x9e\xf1\x99\x26\xd1\xdf\xa7\x22\xfc\x1a\x72\x90\xd0\xca\x4f\x9d\x83\x2c\x4a\xca\x18\x70\xf7\x0d\x82\x7e\x15\xf5\xeb\x52\x9a\x77\x51\x51\x57\xea\x8e\x6b\xe3\x01\x69\xf7\x8a\x9f\x67\xf4\x16\xa5\xea\xea\x8d\x0f\x49\x0e\x5c\x22\xfd\x77\x40\xd9\xb4\x38\x44\xbc\xc8\xc1\x8c\x1f\xbe\xd0\xc9\x8f\xc9\x3b\x0b\x86\xde\x51\xf9\x7e\x39\x41\xb2\x40\x02\x00\x59\x11\xa8\x98\xa2\x4c\x2f\x2b\xd3\x0d\x70\x11\x3f\x1f\x66\xf4\xf6\x05\xee\x7b\x89\xe6\x97\xb7\xdd\x0c\xbb\x4a\x74\x99\xca\x3a\xcd\x35\x74\x3b\x8f\xd5\xb7\x1b\x6e\x05\xdb\x7b\x5c\x2b\x98\x55\xc1\xab\x6f\x21\x54\x94\xf8\x2f\xfd\x10\xe0\xe1\xef\xe4\x96\x88\x94\xd3\x52\x8a\x61\x7d\x4a\xaf\x2b\xda\xf8\x77\xd1\x68\x69\x86\x0a\xd6\x78\x85\x6d\xf5\xef\x1f\xda\xc5\xeb\x58\x17\xca\xbd\x9b\xe9\xd8\x81\x59\x3b\xc4\x3b\xee\x54\xa5\x50\x5c\xdf\xc1\x07\x0e\x85\x3d\x0a\xe6\xd9\x63\x51\xc6\x7a\x5f\x79\x53\x6d\xd3\xc8\x53\xcb\x0b\xa9\xb8\xc7\x01\x47\x1e\xf1\x84\x08\x48\x10\x9e\xc3\xba\x35\xa1\x3f\x0c\x48\xd0\x0f\x2d\xf2\x3b\x09\xef\x78\xb1\x2c\x75\x49\x7a\xec\x4f\x2a\x8d\x13\xfd\x31\xa6\x3f\x4e\x44\x4a\x69\xdf\x44\x4e\x19\x7c\x58\x2e\x26\xc0\x45\xdf\xb4\x90\x77\x39\x24\xad\xd5\xb9\x5c\x3f\xc3\x54\x26\xe8\xf0\x30\xda\x4a\xf1\x49\xed\x46\x82\x0e\x93\x0e\x8d\xd0\xfb\x62\x10\xee\xb7\x4c\x5b\xf6\xee\xfc\x1c\xd6\xdb\xa4\xcf\x61\x6d\xf9\xfa\xe6\x3e\x2c\xf3\x3c\x41\x87\x71\x67\x8e\x15\xec\x23\xa7\x4c\xd7\x2b\xbd\x04\x95\x4e\x5b\xf8\x37\xce\xd3\x66\x9f\x23\xd6\x39\xfa\x5d\x37\x80\xfc\x6f\xe8\xab\x00\x54\xdd\xe3\xb0\xb5\x2d\x55\x33\xb7\x9b\xa3\xf8\x5d\xca\x4e\xf9\xe7\xb1\x3a\x39\x5b\x8b\xad\xe9\xbb\x45\xd6\xf7\x84\xad\x02\xb1\x76\x07\x65\x21\xea\x24\xc0\xb9\x6e\x9b\x5e\xaf\xfc\xcf\xe3\xdb\x57\x84\x33\xca\x66\x2d\xf7\xae\x82\x19\x12\xf4\x2b\x20\x59\x14\x28\x27\x7c\xa6\x7e\xa1\x8c\x8a\x32\x27\x77\x88\x32\x75\xd4\x63\xa4\xa3\x80\x92\xdc\xc4\x80\x7d\x5d\xbc\x5b\x58\xff\x5f\x00\x00\x00\xff\xff\x10\x35\x83\x66\x57\x33\x00\x00")
+var _staticJavascriptsApplicationJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\xc5\x3c\xed\x72\xdb\x46\x92\xff\xf3\x14\x30\xa2\x8d\x81\x98\x04\x25\x6f\x92\xcd\x52\x96\xbd\xf2\xb7\xb6\x12\xdb\x25\xd9\x97\xab\x93\xb4\x3c\x90\x18\x8a\xb0\x40\x00\xc1\x87\x28\xc5\xe6\xd5\x3e\xcd\x3e\xd8\x3e\xc9\x75\xcf\xf7\x0c\x06\x14\xe5\x73\xd5\xb9\x12\x8a\x98\xe9\xee\xe9\x99\xe9\xe9\xee\xe9\x6e\xf0\x2a\xae\xbc\xb8\x6d\x16\xef\x8b\x4b\x92\x7b\x07\x5e\x30\x6f\xf3\x59\x93\x16\x79\x10\x7a\x9f\xbe\xf1\xbc\x2b\xe8\x5f\xc6\xcd\x6c\x01\x7d\xab\x34\x4f\x8a\x55\x94\x15\xb3\x18\x21\xa2\x45\x5c\x2f\x22\xda\x19\x8c\xfe\xf1\x6d\x83\x14\x0e\x82\xd3\xdd\xe1\x5f\xe3\xe1\xfc\xfc\x41\xb8\x33\x0a\xf7\x81\x42\x3a\xf7\x02\x0a\xc4\x08\x7a\x5e\x4d\xea\x1a\xd0\x4f\x9a\xa2\x8a\x2f\x48\x54\x93\xe6\xa8\x21\xcb\xc0\xbf\x48\x9b\xaa\x98\x4e\x28\x1d\x7f\xc0\x46\x3d\xdd\x3b\xa7\x44\x3c\x6f\x91\xd6\x80\x70\x13\x55\xa4\xcc\xe2\x19\x39\x69\xe2\x86\x04\x79\x9b\x65\x03\xcf\x07\x68\x9b\xb7\x32\x6e\x16\x79\xbc\x24\xde\x83\x4e\x57\x4d\xe2\x0a\xb8\x41\xb2\x6b\xf8\xbf\x22\x4d\x5b\xe5\x36\x57\x17\x4e\xae\x00\x69\x1d\x06\xf0\x89\x93\x92\xcb\xc6\x26\xb6\x13\xc5\x1f\xe3\xeb\x13\xa0\x56\x06\x6c\xa2\x0b\x12\x27\xa4\xaa\xc7\xde\x27\xff\x10\x60\x8b\x2a\xfd\x83\x72\xe0\x8f\x3d\xff\x29\x30\x41\x2a\xcf\x07\xfe\x24\x1d\xe4\x66\x8d\x43\x7c\xb3\x13\x24\xc5\xac\x5d\x92\xbc\x09\x29\xd5\x17\x55\x55\x54\x6a\x6b\xc8\x15\xf4\x0c\xbc\xeb\x45\xc5\x86\x46\x6e\xe0\x21\xaa\x61\x51\xda\xda\x3b\x38\x38\xf0\x7e\xd8\xdd\x93\xeb\x0d\xad\xf5\x7f\xa4\x64\x05\xfd\x45\xf9\xae\xc8\xb2\x34\xbf\x08\xf8\xb2\xee\x04\xfe\xb7\xc8\xc0\xa4\x22\xbf\xb7\x69\x45\x12\x3f\x84\x25\x5e\x16\x57\xe4\x59\x16\xd7\x75\xe0\x27\xc3\xbc\xc8\x89\xcf\x97\x0b\xd9\xfb\x06\x65\x02\xd7\x1f\x46\xf2\x9e\xc6\xb3\xcb\x29\x00\x44\xbf\x16\x09\xc9\x22\x72\xdd\x90\x3c\xa1\xf3\x6f\xab\x0c\xe6\x39\xa2\xa3\xfb\x03\x68\x48\xc8\x3c\x6e\xb3\x06\xd7\x83\x0e\xed\x9f\x50\x76\x61\x35\xf4\x7f\x7e\x9a\xa7\x4d\x1a\x67\xe9\x1f\xc0\x25\xc5\x63\x90\x55\x43\x92\xc3\x46\x07\xa6\x9b\xcf\xfa\x5f\x02\x52\xbd\xb0\x00\xb4\xfe\x77\x55\x71\x51\xc1\x06\x1b\x63\xed\xf2\xce\xf7\x71\x05\xbb\x6d\xf1\x21\x3a\x8f\x49\x59\xd4\x29\x08\x45\x4a\x14\x84\xe8\x7c\x56\x2c\x97\x69\x1f\xe6\xcb\x34\x23\xf6\xe4\xb4\xce\x3c\x81\xf9\xb9\x19\x3a\x69\xcb\x12\xb9\x85\xcd\x18\x77\x3a\xa9\x28\xd8\x74\x25\xb7\x70\x28\x7e\x49\x81\x27\xbd\xbf\xd3\x79\x4c\x96\x31\x2c\x18\x2c\xef\xd8\xd5\x09\x07\x92\xa3\x8b\x15\x5c\xe3\x47\x5a\x8b\x55\x1e\x7b\x96\x92\x90\x87\xa8\x81\x53\x8a\x47\x27\x10\x7b\x1b\x52\x61\xf4\xe7\x1c\xd3\xf7\x3e\x7f\xee\x05\x4a\xf3\x86\x54\x55\x5b\xc2\x46\xfb\xfb\x62\xd4\xa4\xad\xe8\x99\x71\x8c\x89\x52\x6f\xd0\xe2\x22\xc2\xc8\x21\xef\x02\x52\xf2\xe7\xef\xee\x8e\xe9\x7f\x3e\x93\xfe\x35\xfd\x44\x79\x06\xa9\xdd\x97\x0f\x35\xd2\x02\xe1\x7e\x0e\x8b\x02\x9a\xa4\xaa\x89\x7b\x20\x7e\x86\x24\x23\x6a\x89\x82\x50\x8d\x0d\xa4\xfb\x68\x69\x72\x2b\x88\xad\x3d\x92\xd5\xc4\x85\x9c\x17\x2b\x71\x6a\x15\xdf\xcb\x14\x8e\x73\x0d\x0f\x07\x14\x74\xc8\x78\xd7\xa6\x42\x66\x45\x9e\xd4\xd8\xff\x2b\xa8\xc4\x68\x9e\x15\xa0\x49\x38\xd6\xc8\xdb\xdb\xdd\xdd\x0d\x15\x34\x2e\x1a\x8e\x05\xd0\x39\x59\xd1\x61\xa9\x8e\xe5\x20\xa2\x1b\x75\xf6\x09\x23\x1c\xf0\x01\x38\x04\x5f\x67\x09\xd8\x14\x47\x27\x6f\x4f\x9a\x8a\x6a\x9c\xa8\x6e\xa7\x75\x53\x05\x7b\x7b\x03\xef\xe7\x90\x6f\x31\x6a\x14\xae\xa1\x6b\xae\x53\x70\x68\xaa\x5f\xb8\xae\xe1\x87\x65\xa3\xb6\x49\x61\x0d\x61\x98\x69\xdb\x10\xd0\x3a\x47\x89\x5b\xe3\xbc\xcd\x92\x77\xb0\x0a\xa8\x80\x85\x6a\x79\x5f\xa5\xa0\xef\xa5\x2a\x92\xed\x87\x60\x0e\x2e\x72\x42\xf4\xb6\x37\x45\x43\x0c\xa0\x63\x32\xc7\xe7\xd3\x73\xfe\xfc\x0a\x8c\x14\x3c\xcf\x63\xd8\x42\xde\xf4\x5b\x7a\x99\x8a\x26\x21\xd4\x40\xa5\x41\xf5\x70\x04\xf3\x02\xa3\x54\xa0\x8d\x38\xf5\xb1\x15\x2c\x99\x3f\xa9\x4b\x32\xc3\x2f\xf3\xf4\x1a\x56\x93\xe0\xd7\x65\x31\xbb\xc4\xbf\x75\xd3\x4e\x69\x57\x7c\x49\xdb\x13\x50\xd5\xb4\x3d\x5e\x96\x19\xf1\x29\x1f\x35\x98\x87\x2a\x6d\x6e\x8e\xe3\xfc\x92\x1a\x9f\xac\x58\x01\x07\x7b\x48\x86\x24\x69\xbb\x84\x87\x87\xf0\xb0\x48\x2f\x70\x25\xfe\x0c\x5f\x67\x00\x0f\x9c\x64\xf0\xf8\xc3\xda\xa6\x71\xcb\x71\x37\x86\x3b\xd5\xce\x09\x6f\xf7\xc3\x73\x3c\xf5\xbb\xf2\x48\xd7\x60\x09\x1b\xa6\x3a\x5f\x83\x07\xb1\x8d\x36\x51\xd0\xbe\x94\xa2\xdd\x81\xf7\x97\x50\x12\x85\xbd\x5f\xc2\xec\x18\xe0\xaf\xa0\x3c\x61\x53\x1d\x94\xe9\x91\x61\xbd\x20\x4e\xf6\x00\x1c\x0f\xc7\x28\xb3\x14\x9a\x87\xf8\xef\xc5\x9b\xe7\xde\xbb\x57\xef\xbc\x93\xa3\x57\x6f\x0e\xdf\x7f\x38\x7e\x41\x5b\x61\xd5\x1f\x86\x51\x59\x94\x81\x29\xfa\x9c\xba\xf0\x53\xc0\x33\x3a\xab\xcf\xea\xef\x47\xe8\xa6\x84\xaa\x95\x36\xee\xb0\x56\xa5\x61\xdf\x83\x08\x1c\x93\x0c\x4e\x4e\xd2\xc3\x3c\xfa\x35\x06\xe7\x28\x47\x54\xa6\x43\x38\x6c\xbf\x14\x2b\x52\x3d\x8b\x41\xc7\x70\xa6\xe6\x45\xe5\x05\x88\x97\x02\xd2\xee\x3e\xfc\x79\xc4\x70\xbb\x22\x18\x65\x24\xbf\x68\x16\x00\xf3\xe0\x81\x52\x5e\xa8\xdb\x70\xcc\x08\xce\x20\xb9\x7e\x3b\x0f\x7a\xb0\x4f\xd3\xf3\xd0\x7b\xec\x0d\xf7\x14\xaa\xda\xc7\xaa\x25\xfb\xbc\x71\xad\xe9\x2f\xde\x4d\x8f\x86\xdc\xc8\x39\x90\x7d\x56\x80\x29\xc8\x9b\xfa\x03\xba\x10\x7d\xd2\x71\xea\x8f\xe6\xd4\xc4\x0e\x40\xf3\xcd\x40\x1f\x7c\x38\x3e\x82\x6d\x2c\x41\x3d\xe4\x8d\xa6\x63\xa5\x05\xbf\x79\xbb\xca\x49\x05\x8a\x76\x6b\x84\x37\xe0\x40\x52\x78\xb7\x24\x0e\x9c\xdb\x70\x1e\x7d\x2c\xd2\x3c\xf0\x47\x7e\xe8\x9c\x94\x36\x23\x38\x71\xd9\x14\x54\x1a\x30\x84\x56\x5d\x4c\x90\x79\x92\x81\x58\x47\xea\x48\xd1\x91\xac\xb5\x09\xc2\x01\x07\xa9\xdb\xd9\x0c\xe4\x6e\xec\x49\x8a\xc2\x7c\x20\xdd\x31\xfb\xc3\x56\x5e\xd7\xbb\xba\x76\x35\x9c\xb9\x67\xe0\x24\x12\xca\xa3\xc3\xa3\x9b\x0b\xdf\x05\x07\x59\xa2\x22\x1e\x0b\x22\xd8\x52\xa2\x3a\x4d\xff\x80\xd3\x07\xc6\x85\x82\xc4\xd7\xe9\xb2\x5d\xbe\xd3\xdb\x69\x47\x53\x34\x71\xc6\x5d\x90\x1c\xc6\x79\xd6\x56\x35\x72\x2b\xdc\x0e\x74\x49\x41\xd0\x54\x03\x3c\x56\x37\x3d\x07\x83\xf6\xc1\x1c\x3e\x01\x09\x40\xf1\x87\x42\x2f\xf9\x6b\xcd\xbe\x11\x92\x64\x78\xf2\x77\x22\x54\x18\x01\x7a\xbf\x62\x3a\x13\x76\x2f\x80\x73\x74\x15\xc3\xd2\x86\xba\x0d\x65\x94\x10\xcf\x44\x10\x1a\x8e\xa1\x28\x8c\x24\x9d\xcf\x4f\xb8\x33\x6e\xe2\x60\x8f\x09\x8f\x27\x8c\xf3\x75\x0f\xdd\x20\x5f\x9d\x20\x3a\xa7\xe8\x77\x6a\x12\x11\x40\x37\xfe\x88\x26\x19\x73\x23\x6a\x7c\x8b\xaf\x36\x05\x9d\x51\xa4\x01\xa6\x97\xfa\x68\x76\x3b\xac\xf8\x22\xce\x2f\xf0\x7a\x60\x0d\x82\x90\x30\x80\x42\xd8\xef\x9e\x70\x0a\xa9\x0e\x03\x81\x8b\xdd\x3b\x53\x3f\x67\xe8\x7a\x0e\xbc\x19\x95\x00\x7d\x5b\x93\xb8\x89\x85\xbe\xa3\x64\xc4\xb2\x61\x47\x44\xd1\x84\x6b\xb3\x4c\x25\x1d\x0a\x6e\xc9\x9d\xb6\xdc\xe6\x30\x9c\x16\x6b\x04\x62\xec\x8b\xbd\x52\x94\x24\x97\x48\xba\xde\xa6\x83\xa9\x77\x47\xf1\x14\x64\xd0\xf4\xd5\x0c\x74\x3e\x1f\xba\x10\x81\xce\xc4\x98\x7e\x0e\xa4\xd3\x8a\xf7\xb0\xb1\x77\x8f\x71\xd4\x39\xec\x4a\x8f\xc8\xe3\x3a\x00\xa4\x1a\x34\x1a\xb8\x1f\x5e\x51\x62\x4b\xad\x2b\x64\x05\xa8\xf1\x82\x13\xd9\x77\x81\xd0\xf3\x09\x00\xd4\x6b\x3d\x02\x1d\xc9\x29\x46\x78\xd7\x04\xad\x77\xcc\x87\x7a\x4d\xef\xb9\x81\xff\x9f\xc3\xf7\x88\x31\x7c\x56\xb4\x39\x3a\xb4\xd2\xec\x77\x28\xab\xd3\x0e\xe4\x6f\xa5\xfa\x06\xa0\x87\x0c\xdc\x0f\xdd\x9c\x56\xe9\xc5\x05\x02\xa3\xf2\x01\x83\xa0\xba\x24\xfc\x7a\x60\xea\x3f\x5c\xde\x39\x2c\x96\xcb\xff\x50\xbb\x83\xc2\x13\x30\xe9\x02\x8d\x4c\xdb\x99\x99\xe4\x32\x26\x94\x5d\xa8\x93\xcd\x8a\x38\xb9\x9d\xaa\x89\x2f\xd1\x11\xf9\xd7\xa2\x22\x9b\x2e\x3c\x6a\xf9\x2c\x01\xec\xa1\xce\x99\xd5\xd0\x94\x68\x0a\x53\xc0\x7d\xf0\xb9\xb2\x06\xe8\x86\x0b\xe3\x10\xc8\x7b\x3f\x0b\x93\x3c\x67\xa7\xfe\xd6\xdb\x3f\x55\x77\x4e\x57\xfc\x0d\x59\x39\x2e\xc1\xd2\x93\x06\x29\x28\xb2\x2b\x92\x98\x30\xb2\xfb\x83\xd0\x47\x46\xbf\xec\x06\xe2\x8e\x5b\xbd\x46\x1c\x0f\x56\x62\x81\xe8\xd8\x8e\x6b\xbf\x8d\x6d\x81\xb0\xee\x35\xb3\x80\x70\x5e\xb4\xfd\x13\x47\x52\x6c\xd6\x24\x22\x31\x1c\xfc\x49\x74\x49\x6e\x6a\xb6\x53\x62\x79\xc0\xa7\x90\x68\xd0\xab\x5f\x60\x19\x8d\x53\x68\x3d\x87\x95\x37\x9f\xe1\xa0\x9d\x9e\xef\x6b\x02\x2e\x55\xaf\x80\x73\xdc\xb6\x8c\x9d\xa4\x77\x2e\xd5\xa2\x47\x79\x30\x74\xa4\xef\x35\x0d\x25\x19\x57\xaf\x31\x5e\x4c\x00\x72\x02\x57\xc1\x26\x4e\xd1\xcb\xd2\x7c\x03\xda\x45\xd7\x85\x85\x9e\xde\xa7\xb3\x4b\xa2\x99\x79\x71\xc5\xb7\xdb\x39\xf8\x11\x86\x05\xae\xd0\x51\xf8\x91\xb9\x0d\x32\x40\x44\xfa\x0e\x19\xdc\x6d\x81\xbb\xf7\x05\x5b\x5b\xca\x06\x5e\x72\xa8\xc4\xf8\x03\xa1\x8b\xc1\xa1\xad\x42\x85\x44\x2f\xce\xcf\x0d\x5e\x84\x0a\x57\xfd\xae\xe8\x59\x1e\x67\x37\x75\x5a\x4f\xa8\x93\x55\x7b\x31\x98\x77\x60\xc8\x9f\x65\x29\xbd\xb3\x31\xe4\x45\xb1\x62\x91\x1b\x5d\x51\x20\x07\x9b\xce\x39\xe5\xbc\x27\xaa\xc0\x79\xea\x04\xf4\xb4\x1e\xf7\x64\xd6\xae\x31\x16\x71\xfd\x8c\x1d\xa8\x40\x05\xcb\xec\xd1\xda\x12\xac\x13\x11\xdd\x5b\xd3\x93\x47\xd4\x4d\x4f\x57\x31\x5b\xd1\xd3\xc2\x63\x6e\x8a\x0a\xe0\x0e\x3c\xe2\x4d\xa2\x8f\x41\xe8\xdb\x9a\x92\x88\x09\xba\x69\xf1\xde\xad\xa9\x19\x4a\xc6\x4d\x52\x07\xd9\x9a\xae\x50\x7d\x6e\x92\xbc\x77\x7b\x2e\xbb\x91\xc5\x50\x46\xfa\x36\x82\x63\xac\xb1\x67\x5a\x02\x68\x6b\x2e\x78\x64\xd4\x4d\x8e\x75\x06\xa6\xe1\xf3\x3c\xfd\x50\xf7\x69\x13\x43\x6d\x51\x5f\xba\x11\x3a\x29\xe8\x60\xe8\xe1\x76\xc6\x22\xf3\xf2\xc4\xc0\x03\x83\xa6\xa0\xa3\x94\x82\x76\xa2\x37\x69\x06\x93\xa7\xae\x3b\x3a\xcb\xe0\x32\x23\xb9\xec\xa2\x38\xd7\xe1\xb9\xa5\x88\xdd\xcb\x61\x42\xdd\x65\x3d\xd8\x4e\x08\xfc\x20\x14\x2b\x22\x83\x90\x72\x05\xb6\xe3\xc4\xa6\x67\x45\x63\x4d\xbb\xb2\xdd\x22\x99\x38\xf6\x2a\x99\x03\x3a\xd8\x42\x7b\x30\x8b\xab\x64\x22\xe8\x4c\x80\x72\x8b\x01\xa5\x06\x0c\xa6\x2e\xb8\x89\xe4\x5a\xcd\xdc\xd4\xaf\x3d\xf7\x5c\x91\xe3\x91\x46\x09\x9e\xde\x17\xaf\xdb\x65\x9c\x6b\x56\xa9\x49\x9b\x4c\x0e\xeb\xbf\xa2\x39\xac\x31\xcd\x36\x31\x0c\xcd\x7e\x95\x7c\xbc\xc9\x34\xae\x04\x06\x07\x8a\x66\x98\x01\x5a\xa5\x49\xb3\x10\x86\x8c\x71\x4f\x43\x1e\xca\x4e\x00\x59\xff\x4f\xbe\xbd\xfe\x1a\x68\x7f\xa0\x5f\xed\x86\x83\x17\x23\x11\x25\xfa\x86\xd0\x37\x8c\xf3\x74\x89\x51\x32\xcf\x68\xad\xe1\x1e\x50\x22\x51\x23\x94\xbe\x95\x39\xfd\x9a\xa3\x9b\xcb\xe6\x83\x78\xcb\xc5\xb1\x44\x49\xd8\xbe\x4d\xa2\x24\x43\x07\x42\x94\x16\x69\x42\x82\xae\x44\x89\x84\x02\xb7\xb5\x34\x1a\x38\x8b\x33\x22\xa2\xef\x61\x34\x87\x0b\xd5\x11\x78\x26\xf3\xb8\x6e\x7c\x5b\xec\x94\xd1\xec\x13\x3c\x09\x20\x84\x4f\xdf\x60\xcd\x26\x2b\x31\xd0\x50\x1e\x7b\xbb\xe6\x62\x9b\x73\x4b\x48\x3d\x93\xe2\x2a\x23\x52\x01\x15\x58\x49\xa4\x33\x25\x94\x3b\xad\x3f\xf4\xdd\x39\x94\x2d\x87\xeb\xdd\x23\x30\xff\x9b\x37\x08\x00\xb6\xdc\x1d\xea\x65\xdc\x75\x6b\xb8\xd3\xb0\x89\x87\x19\x03\xd9\x8a\x0b\xe9\xa1\xdc\x95\x0f\xdd\xd3\xd8\xc4\x4c\xa5\xc1\x6d\xc5\x91\xe9\xe5\xdc\x95\x2d\xee\xad\x6c\xe2\xa8\x61\x20\x5b\x31\x23\x5d\xa3\xed\xf9\x50\xde\xbd\xc6\x04\xd1\x8d\xf6\x3d\xab\x58\x40\xdd\xd0\x74\xe7\x86\x44\x20\xc9\x98\xdb\x7f\xce\xae\x83\x7a\x48\x91\xdd\x2e\x7e\xa3\x77\x37\x55\x8b\x51\x94\x04\xd8\xa1\x59\xa3\x69\x16\xe7\x97\x42\x86\x77\x70\x2e\x7f\x3f\x79\xfb\x26\xf0\x47\x0c\xd3\xd7\x6e\x96\xac\x45\x71\x82\xf4\xa7\x59\x31\xe5\xf7\xc0\xa7\xf0\x35\x38\x45\xec\xa8\xa6\x53\x4f\xe7\x37\x1c\x67\xc0\x6e\x67\xde\xc3\xf0\x7c\xe0\x7d\x6a\x6e\x4a\xcc\xb6\xc5\x65\x99\xa5\xac\xb8\x62\xf4\xb1\x2e\x72\x7f\x2d\xef\x22\x3a\xd7\xb2\x02\x03\x86\xf9\x70\xfc\x4b\x34\xab\x08\xec\xde\xdb\xe9\x47\x32\x6b\xe0\x39\x40\x0e\xc2\x7d\x3b\x4a\xa3\xbb\x6f\x3d\x9a\x89\x5f\xbc\xba\x5a\x49\x78\x84\x4a\x23\x09\x50\x30\x43\x96\x3e\xb2\xae\x71\x52\x48\xf6\x7b\x77\xab\xef\xf2\x47\xa5\x8a\x3d\xbb\x34\x96\xce\xc3\x9e\xf7\x04\x34\x18\x6d\xf0\xbd\xb1\xf8\xca\x2d\xaa\xb7\x5a\x80\xbe\xf0\xe8\x10\x58\x0c\xe1\xcd\xc4\xc1\xed\xbd\x7c\x62\xe6\x6a\x21\xd2\xc4\xfa\xc9\x15\xce\x74\xcf\x0a\x8a\x38\x6a\xe7\x60\xca\x7a\x02\x6d\x0d\x39\x70\x77\x09\xc1\xa3\x21\x93\x8c\x81\xdf\xba\x7a\x38\x6c\x25\xae\x0b\x9b\x86\xd6\xee\x14\xfb\x1a\x26\x78\x9d\x7a\x96\xda\x4e\xaf\xbb\x29\xb1\xeb\x86\x3a\xd9\xef\xd3\xa5\xdc\x1a\x45\x1c\x37\x10\x68\xfb\x87\xef\x8e\xbc\xdf\xdb\x02\xc3\xb2\x68\x88\x24\xb7\x6e\x3b\x54\xcc\x29\x14\x9d\xbf\x1b\x82\x87\x5c\x6b\x45\x69\xc0\x26\x02\x72\xd3\xf0\x21\xe0\x49\xad\xb4\xb6\x3e\xb8\xda\xde\x77\xdf\x79\xf7\x6e\x0f\x0c\x68\xdc\xe3\x8e\xf0\xcd\x25\xd7\x33\x42\x12\x92\x0c\xbc\x55\x9c\x36\x48\xb3\xcd\x9b\x34\xb3\x87\x55\xa2\x6d\xec\x26\xd3\x94\xf0\xd1\x11\x30\xc3\x03\xdd\xe8\xb3\xb2\x01\xea\x55\x8a\xf7\xa1\x3e\x0f\x51\xa0\xcd\x62\x30\xe0\x66\x2d\xd0\x58\xbb\x50\x50\x0f\xd8\x3f\xd2\xbb\x85\xa8\x4d\x41\xad\x5c\xee\x6b\x44\x2e\xe2\x66\x41\x2a\x37\x85\x57\xa2\xcf\xd3\x8d\x56\x3f\x2d\x79\x18\x1d\xb4\x0e\xe5\x41\x35\x68\xf5\x91\x92\x35\x31\x5d\x4a\x62\x5b\xfb\xf9\xd0\x5d\x68\xd7\xba\x98\x95\x34\x16\x09\x1e\x6c\xec\xe2\x7d\xc8\x2f\xf3\x62\x95\xbb\x70\x8c\x7c\x0e\xc7\x40\x99\xa6\x2e\x9a\x4c\x10\xf4\x5f\x11\xd8\x1d\x21\xf4\x55\x19\x99\x5e\xf4\xc1\x43\x8c\xb2\xf0\x03\x9f\x83\x4f\x18\x3c\x44\x49\xb4\x63\x8b\xa1\x9d\xbb\xbc\x2d\x42\xd9\xc4\x17\x98\xca\x85\x63\xdc\xb0\xc8\x24\x35\xb2\x2a\x16\x4d\x43\x75\x5e\x93\x44\xb3\x22\x1b\xd2\x0c\x7b\x8c\x55\x1e\x28\xe9\x7c\x04\x7f\xa0\x6a\x37\x96\x25\x26\xe8\xc7\xde\x24\x12\xdf\x69\x1e\x51\x3c\x08\x2f\x13\x75\x60\xb3\xc4\x4c\xe2\x57\x0f\x5a\xee\xdf\x86\xc5\xae\x2e\x0a\x0b\x9f\xb6\x08\x3c\x52\xe8\x1d\x0c\xab\x20\xe3\x3c\xd3\xcf\xa7\xa8\x6d\x6d\x2c\x2a\x6d\x6a\x50\xa5\x98\xb0\x0a\x7c\x31\x67\xfd\x96\xe8\xbe\x0f\x1a\x45\x0e\x9d\x80\x26\x0e\x1e\x27\x09\xbf\x74\x61\x99\xc1\xb0\x62\xa0\x7e\xe8\x10\x44\xc4\x51\x79\xc5\xa2\x82\x5b\x59\x83\x39\x01\x96\x8b\xef\xd3\x46\x58\xdb\x81\x25\x53\x0e\xab\xa3\x55\x53\xf0\x12\x90\x91\x6e\x76\xd0\xd5\xa7\x55\xa5\x18\xf6\xa0\x64\xf4\x0a\x10\x96\xf9\xad\xc0\x9d\x29\x68\x2a\x9a\x12\x27\x59\x96\x96\x60\xa1\x61\xe7\x03\x8e\x22\xeb\x03\x06\xde\x4f\xbb\xe0\x4b\xfd\xa8\xad\x94\x86\x6f\xe5\x74\x45\x09\xdb\x23\xf0\xc9\x8a\xfc\xe2\x31\x1e\xbc\x49\x04\x17\x99\xb8\x24\x81\x60\x8c\x1e\xb3\x47\x23\x01\xe2\x58\x32\x89\x22\x47\xa2\x38\x23\x9f\x62\xde\x91\x36\x5d\x77\x6d\x86\xda\x8a\x03\xd8\xc0\x5b\xa6\xf9\x2f\x3c\x5d\x46\x92\x0b\xc2\xbe\xab\xba\x54\x8c\x1e\x71\x0b\x09\x0f\xfa\xc5\xb1\xa9\x78\x9e\xcd\x7b\xa4\x88\x60\x48\x51\xef\x39\x00\x5f\x4a\x52\xf5\xbe\x07\xa7\xb4\xb3\x5a\x00\xde\xa9\xf4\x03\x14\x06\x73\xe0\x1d\x56\x55\x7c\xa3\x13\x79\xe0\xed\x85\x7c\x7f\x22\x7d\xe3\x97\x69\xc2\x21\x0e\x74\x16\x86\x9e\xc9\xc0\xbe\x5e\xa7\x03\x3a\x38\xa7\xa3\xf8\x54\x49\xd2\x71\x61\x05\xc3\xe8\x13\x3e\x2a\x8a\xd0\xb6\x36\x21\xfc\x7d\x53\xdb\x56\xb2\x6e\x08\x35\xe4\x31\xb9\x78\x71\x5d\x06\x7c\x04\x10\x22\x7f\x67\xef\xdf\xff\xfc\xd7\xce\x43\xeb\x46\xc2\x55\x97\xeb\x4a\xd2\x77\xd1\xe0\x79\xf3\xea\xf2\xb0\x3e\x21\x98\x41\x55\x21\x74\xba\x0a\x45\x12\x67\x9a\xae\xe6\x23\xfc\x8a\xcd\x32\x8f\xcd\xb3\x3e\x9a\x3e\x12\x77\x01\xcc\x08\x8a\x4a\x88\x09\xa5\xe5\x45\xf4\xcf\x70\xc6\x8a\x5a\x7c\x23\x83\x25\x47\xe3\x6a\x4f\x4f\xba\x18\x54\x60\x49\xe9\xdf\xa0\x83\x48\x63\xaf\x2f\xb5\xaa\x19\xcd\x71\x31\xa7\xb9\x49\x1b\xce\xb2\xa2\x06\x4d\x04\xfa\x68\x5a\x24\x58\xed\x81\xa3\xc3\x53\x15\x35\xf1\x34\x23\xc3\x9a\xd3\xb0\x63\x46\x76\xef\xfe\x37\x7d\x7a\xce\x01\xe8\x2a\xd1\xb9\xcd\xce\xa9\xb4\x37\x4c\x87\xe3\x7c\x89\xf1\xd1\xeb\x09\x7c\x60\xd3\x34\x3f\x9c\x9b\x7e\x2b\x64\xa0\x63\x39\x8e\xcc\x82\xc1\x77\x31\x97\x2d\xd1\x79\x42\xdf\x48\xa1\x80\xe3\xea\xc4\x66\x89\x46\x61\x33\xc7\x32\xae\x33\x00\x5d\x96\x90\x29\xe0\xcd\xb8\x1d\x63\x94\x06\x98\x51\x0c\xbb\x52\xa5\x95\x02\x61\x22\xef\x92\xdc\xb4\xa5\x83\x08\x03\x12\xa3\x80\x1e\xef\x25\x26\xcb\x84\x68\x5e\xd0\xb0\xe8\x26\x11\x17\x3a\xaf\x18\xfa\x12\x54\xac\x25\x80\x23\x52\x11\x2b\x25\xe9\x88\xd2\xcf\x35\x11\xd3\x76\x20\x12\xe5\x08\x41\x68\xa6\x97\x5d\x87\x10\x87\x40\xc5\x13\x4d\x6b\x76\x20\x8d\x98\x03\xaa\x1b\xfd\xbe\xa8\xde\x70\xe0\x8b\x9c\xa0\xeb\x39\x70\x68\x2b\x61\x15\x76\x02\x12\xb1\x48\x4e\x08\x6e\x44\x00\x4e\x70\xd9\x62\xb1\x0f\x9c\x80\x18\x3c\xd5\x81\xc7\x4e\x90\x9e\x01\xb2\xef\x9e\xca\x14\x68\x77\x11\x82\x89\xf8\x67\xa0\xaa\x8c\x52\x19\xf4\xb3\xff\xfc\x97\xb1\x46\x88\x79\x00\xa2\x16\xd9\x58\x31\x54\xa7\x69\xd1\xd6\x7c\x37\x02\xad\x4c\xc5\x70\xa3\x15\xe5\xbf\x6e\x49\x19\x8b\x36\xb6\xa1\x6a\x39\xf5\x9b\x27\x8e\xab\xc9\x47\x11\xa6\xcc\xba\xcf\x6f\xc6\x37\x38\x8c\x61\xbb\xae\x88\xe4\x71\x1b\x1d\xa8\xd1\xb8\x45\x0d\xaa\xf5\xd9\xca\xf8\x68\x06\x48\xd0\x37\x1d\x54\x59\x32\x79\x17\x8b\xa4\x0b\xfe\x26\xcb\xb4\x95\x75\xda\xca\x42\xe9\x23\xae\x59\xa2\x8c\x1e\x93\x45\x9a\x24\x24\xbf\xeb\x01\x6b\xf3\x29\xb5\x58\xe2\x90\xf5\x45\xd9\xfa\xac\x83\x51\x36\x46\x8b\xa6\x02\xfb\x36\xd1\x75\x36\xf8\x22\xe8\x9e\x37\x6f\x7a\x91\x99\x5b\xc8\x6e\x7b\xe6\xb6\xad\x43\xb9\xb6\xe0\x42\x0b\x9d\x23\x09\x84\x51\x5c\x96\xd0\x2f\x6c\xc6\x0e\xc9\xba\x51\x73\xb0\x13\x3d\x57\x00\x51\xd9\x66\xcf\x8e\xb6\x6b\xd7\x16\x06\xd6\x8d\x74\x49\x0d\xcb\x95\xab\x92\x61\xfd\x75\xa9\xfe\xb0\xa1\xc4\x9f\xb1\x52\x39\x9e\x89\x38\x01\xe5\x89\x2a\x00\x9d\x41\x9b\x35\x76\x4c\x37\x86\x9e\x28\xbb\xce\x90\xa3\x9a\x08\x8b\x38\x8a\xd3\x40\x63\x8e\x73\x55\x9f\x71\x8b\x0d\x69\x8a\x8b\x8b\xcc\x7a\x31\x6c\xc0\x43\x52\xce\xea\x3e\x17\x41\x4e\xab\xff\x25\x33\xea\xbf\x6a\x9e\x42\xcf\x1e\x56\xc5\x4a\xc6\x7c\xa9\x97\xb6\x48\xb3\xa4\xc2\x70\x78\x83\xd9\xbb\x84\x34\xb1\xca\xbb\x0b\x84\xa7\x37\x47\x98\xbe\xfa\xc4\x6b\x82\xb1\x89\x15\x63\x39\x4c\xa2\x80\x3f\xdd\xa1\x32\x66\x5f\x75\xc1\x06\x25\xe7\x9c\x01\xd3\x36\xda\xeb\x61\x0e\x60\x9d\x0b\x1e\xe9\x13\x63\x09\xad\x05\xb4\x75\x65\xac\x9c\x46\x2a\xf6\x6e\x78\xab\xc6\x9e\xb9\x09\x6a\x4d\x0d\x3d\x7d\xcb\x5b\x17\x38\x54\xaf\x97\x2b\x29\x6a\xb6\xe9\x16\x7a\xb6\x8d\x40\xcc\xc3\x2c\xe3\x7b\x35\x4f\xab\xba\xd1\x54\x8a\x65\x4d\xef\x48\x1b\xb1\xfb\x69\x9b\x5e\x53\x4f\x8d\x45\x6f\xc9\x36\xab\xb4\xae\x78\x71\xa2\x7f\xbb\xff\xc4\xaa\x41\x83\x6e\xc1\xa5\x55\x6f\xd9\x35\x6c\x5a\x10\xcc\xff\x96\x6e\x81\xcc\x5f\x7a\xec\x22\xb2\x76\x14\x65\xde\x76\x45\x10\x35\x79\x0a\x63\xbb\xa0\x96\x98\xf2\xd7\x8d\x6e\xd5\x37\xf9\x6c\x8b\xd8\x96\xe5\xd8\xf3\x58\xf1\x40\xab\x79\xbd\x93\xe3\xcc\xd4\x98\xa8\x30\x0d\xcd\xc2\xf4\x1e\x89\xa0\x2c\x6c\x7c\xb5\xd1\x8a\x64\xb9\x8a\x7f\x6e\x0d\xc0\x89\x77\x07\x8c\xc8\x94\x9a\x1a\x76\x4d\xea\x76\x09\x57\xd7\x1b\x61\x34\xf8\x98\x98\xd5\x2d\xc1\x0b\x4e\x3c\xf0\x69\x17\xf2\x04\x89\x8d\xa6\x19\x0c\x51\x68\x0e\x54\xd8\x4c\xf4\x1a\xdc\x50\x38\x82\x68\x50\x40\x0c\x07\x4e\x8c\x4e\x59\xae\x81\x26\x84\xc4\x8b\xf3\xc4\x89\xde\x2d\xdb\x35\xf0\xe5\x5b\x06\xf2\x38\xed\xf7\xf1\x6d\xa5\x8c\x4d\xde\x7b\xc7\x77\x95\xee\x5a\x33\xa0\x00\x46\x04\xbf\x97\x07\x95\x29\xbe\xdb\xf0\x4e\x3c\x31\x32\x4f\x55\x47\xcc\xf3\xdc\x60\x27\x0d\x2b\x38\x89\x96\x71\x19\x6c\xda\xa7\x81\xd7\x6f\x81\xb8\x36\x05\x11\x7b\xd4\x54\x8f\xc5\xd1\x36\xe3\xbf\x02\xc9\x2c\x64\xb3\x63\x1a\x00\xdd\xdc\x04\xa1\x6e\xa6\x36\x1c\xcd\xcd\x3e\x80\x79\x4c\xfb\xbc\x00\x21\x72\x1b\xde\xb3\x61\x07\x55\x2a\x6d\xc5\x8f\xa9\x55\x7b\x1d\x9c\x4a\xd7\x14\x3a\xa6\xad\x14\xfb\x49\xdc\xb3\x68\xb0\xec\x1e\x6f\xfa\x22\xd7\x72\xbd\xa9\xa0\x5b\xcf\xa7\x98\xad\xa6\x41\xe9\x4c\xc0\xb6\x2c\xfa\x25\x6b\x63\x86\x65\xdb\xac\x88\xbc\x13\x19\xd6\xa3\x21\xc0\x09\x26\x25\x99\xd3\xfa\x8e\x85\x36\xf1\xad\x58\x3a\xd7\x51\x10\x3c\xfc\xf1\x74\x77\xf8\xe3\xf9\xe7\x87\xf0\xe7\x87\x73\xfc\x91\x89\xf3\xcf\xa7\xbb\x7b\xe7\x4f\xe8\x57\xfa\xf1\x24\x3c\x8b\xfe\x7f\xe0\xc2\xd1\xc5\x32\x1d\x70\x56\x4f\xe3\xe1\x1f\x87\xc3\xff\x82\x9e\xe8\xde\xb7\x3b\x7f\xfa\xee\xfb\x07\xa3\x83\x27\xff\x98\xfc\xf7\xa7\xcf\xeb\xff\x19\x9e\x3f\xf8\x9b\xea\x3f\x0f\x9e\x8c\xd5\xd3\xf0\xfc\xd3\xee\xe0\xa7\xbd\xb5\xd6\x1f\x3e\x01\x88\xb3\xe8\x4e\x18\xe1\xf7\x06\x37\xc1\xd9\xea\xfb\xf1\xd9\xe8\x6c\x14\x06\xa7\x67\x09\x00\x9e\x45\xc0\x04\xce\xec\x94\x3e\x9c\x7f\x7a\x38\xf8\x69\xdd\x99\xc1\x1c\x88\x9d\x0d\xcf\x76\xce\x46\x00\xb0\x3b\x58\x1b\xfd\x6d\x0d\x9b\x83\x09\x01\xbd\xb1\x26\x33\x50\x23\x46\x53\x09\x62\xbb\x0a\xe0\x16\xf0\x24\x31\xda\x01\x30\x09\xea\xcf\x70\x35\x05\x9f\xc1\x1c\x3a\xa6\xaf\x36\x05\x93\xcf\xc3\xcf\x51\xf8\x84\xfe\xe8\x86\xec\x3f\xef\xcd\xdc\xc9\x0b\xf7\x15\x88\xe5\xa4\x8a\x57\x22\x7b\x77\x1c\xaf\xc4\xbd\x5a\xbc\xb2\xed\xc2\x58\x90\xeb\xa4\x5d\x96\x02\xeb\x35\xb9\x7e\x0e\x8f\x36\x66\xdd\x4e\x31\x91\x2e\x51\x1b\xfa\xe6\xf8\x04\xd3\x4e\x14\x33\xbe\x22\xec\x65\x72\x95\x2c\xfc\x9a\x99\x36\xfe\x0a\x3e\x1c\xe9\x67\x59\x5a\x4e\x8b\xb8\x4a\xfe\x7e\x12\xdc\x8f\xa6\x4d\x7e\x7f\xa0\x6a\x5a\x45\x96\x74\xec\x89\xfb\x3f\x5a\x84\x17\x19\xc1\xaf\x78\x65\x08\xee\x1b\x47\xf1\x7e\xe8\x7a\x6d\xc4\x48\xac\x59\x2b\xd9\x53\x68\xd5\xd9\x03\x5d\x77\x31\x1f\xdd\x77\x04\x0f\x8d\x0d\xb0\x4c\x42\x17\x8b\xb2\x4c\x2b\xee\x34\x1c\xbd\xd4\xc4\x02\x9a\x89\x3d\xec\xd6\x2d\x74\x37\x7a\xfb\x89\xdd\xc2\x65\xcf\xdc\x36\x2d\x87\x9b\xe7\x0d\x33\x53\x64\xed\x89\x49\x39\xbc\x4b\xe6\x87\x96\x80\xb5\x4d\x43\xab\xb3\xf4\x39\x70\x29\x47\xaa\x3e\xde\xad\x8a\x12\x2c\x51\x5a\xa3\xf9\xa0\x79\x81\xaa\x25\x7a\x86\x48\xbe\x5b\xef\xa0\xb1\x94\xaf\xd7\x9b\x21\x42\x7c\xbd\x2f\x41\xbf\x8f\x95\xde\x0c\xf9\x0b\x8e\x32\x2c\x62\x64\x4e\xd8\xc1\x40\x76\x64\xd0\xcf\xfe\x0d\x07\x17\xfb\xdc\x67\x67\x0e\x81\x88\x01\xea\x3f\xf2\xc0\xaa\xe6\xec\xb7\x82\x27\xb1\x80\x10\xaf\x05\x4b\x5c\xf1\x63\x10\x8e\xb8\x5f\x4e\xbb\x8c\xc1\xd6\xea\x88\x96\x31\xbd\x66\xe0\xca\x09\x62\x58\x92\x63\xb6\x74\x5f\xf2\xd4\xc3\x01\x6c\xa7\xba\xbb\x41\x5f\xa5\xd7\xe3\x8c\xfc\xc7\x08\xf4\x94\xba\x63\x81\x4f\xe2\x2b\x3d\xde\xba\xb6\xde\x1e\x97\x2c\xf0\x6b\x9b\xfd\x3e\xdb\x5d\x38\x92\xf5\x7a\xda\x3b\x6c\x91\xf8\x82\x25\x87\x58\xe4\xe4\xec\x88\x84\x6c\x3d\xb9\xa5\x7f\x2c\x04\x02\x94\x61\x9b\x25\x1e\xec\x86\x37\x25\xf4\x5c\xa8\x72\x98\xde\xb5\x61\x82\x68\x94\xf1\x6d\x78\x87\x14\xb6\x2c\x9f\x61\x79\xc2\x17\xfc\xf0\x04\x93\x64\xd7\x0f\x57\xe8\xaf\x32\x08\x36\x55\xd2\x7b\xef\xc7\xdd\x8e\xeb\x2e\x93\xf5\x1c\x3c\xdc\x94\xf9\x17\x24\xd5\x0f\x69\x20\x49\x1a\x4a\xfc\xf7\x3f\xff\xa5\x12\xfb\xb7\xfd\x1e\x85\x1e\x84\x71\x16\x77\x68\x94\x9e\xa6\x79\x6c\xbc\xbb\x8f\xc1\x34\x8b\xd0\xe8\xf4\xec\x7a\x77\x77\x08\x1f\x3f\xc3\xff\x2f\xe0\xcb\xde\xcb\xf3\x11\xfd\xb1\x09\x06\x2e\xe9\xe1\x4f\x97\x64\xf0\x3f\x7b\xf1\x44\x77\x1d\xf5\x13\xb3\x88\x6f\xe0\xdc\xcf\x2e\x0d\xa3\xdb\xeb\x6c\x46\x60\xc8\x5f\x18\x91\x3a\x91\x61\x97\x8b\x2d\x08\xc2\x0e\x8a\xaf\x32\x33\xcf\x81\xe1\xda\xfc\x08\x33\xcb\x8f\x77\xf6\x1e\x8d\xe8\x17\x3f\x74\xbe\x98\x29\x08\x98\xd1\x87\x97\xee\x1f\x8a\xd0\xa5\x88\x9a\x87\xeb\xc6\x88\x3c\xba\xde\xb9\x38\xa4\xa8\x34\x4c\xe1\xf9\xcf\x49\x46\x1a\x62\xbd\x6d\xa1\x59\x93\xba\x4c\x73\x70\x1a\xf4\x22\x2b\x5a\xec\xfc\xb6\x6d\x78\xb5\xf3\xc0\xad\x89\x4c\xb9\xe6\xbc\xe9\x6c\xd0\x60\x34\x0c\xfc\x04\x43\x14\x6c\x62\x18\xaf\x4e\x28\x43\x09\xad\xa8\xa9\xbd\xb8\x22\xf4\x98\xa2\x29\xcb\x23\x1a\x96\xa6\x57\x18\x56\xf1\x0a\x37\x41\xaf\x26\xc4\x9b\xf5\xe1\x47\xbe\x99\x7f\x71\x18\x53\x63\x66\xd4\xe9\xf2\x1f\x25\xe9\x95\x37\xc3\xa3\x7f\x70\x3f\xce\x48\xd5\x78\xf4\x73\x98\xe6\xf3\xe2\x3e\x5c\xac\x33\xc2\xdb\xef\xd3\x7a\x18\x31\x4b\x5a\x04\x03\xa8\x8f\x7d\x57\x3d\xb8\x99\x2a\xea\x46\xff\xf5\xd8\x90\x9e\xf5\x71\x9e\x0b\xb6\xbc\xab\xa2\x62\x2f\x5a\xa1\xef\xf7\x1b\x7d\x08\xfc\xd1\xc7\xf8\x2a\xae\x67\x55\x5a\x36\xf5\x48\x1e\x87\x09\x83\x8d\x3e\xd6\x8a\x1b\xde\x54\xe4\x6a\x9b\xfa\x72\x46\x5f\x24\x16\x93\x88\x26\x97\x9c\xd2\xa1\x49\x6c\x2e\xeb\x8b\x37\x1c\x5e\xc6\x50\x24\x0f\xfb\x2d\x9b\x2a\xb6\x92\x3f\x1b\x28\xb8\x58\xaf\x99\x8f\x44\xd7\x74\x60\xb0\x65\x38\xca\xbe\xc3\xad\x1a\x18\xc0\xd3\x18\x5f\xe8\xf6\xa1\xd3\xea\xa0\x2f\xf9\x8c\xbd\x9f\x2d\xf0\x9b\x86\xbc\xaa\x8a\xb6\xa4\xc1\xeb\x3d\xb3\x13\x39\x36\x6d\x3d\xfb\x07\xbb\x99\xa6\xae\x8e\x0c\xb8\x7c\xd3\x2e\xa7\xf4\xa7\x04\xbb\xdd\x75\x73\x93\x91\xb1\x35\x3b\x1d\xeb\x17\x32\x07\xe7\xe2\xfe\xfd\x41\x2f\xc4\x31\xee\x06\x80\x8c\x3b\x30\xac\xfe\x9f\x53\xf8\xdc\xd3\x2d\xd0\xbb\xfd\xb0\x60\x7d\xa3\x43\x97\xc0\x73\xf5\xbd\x69\x33\x58\xa5\xfb\x51\xa7\x2f\x2f\xf2\x77\x30\x28\x8d\x5f\x38\x01\x18\x4f\x3d\xf8\x6b\xed\x69\xbd\x8d\x88\x75\x44\xbf\x7b\xdc\xad\x9f\x80\x63\x96\x8e\x9d\xe3\xd0\xda\x16\xf9\x2e\xb8\x75\xf3\x30\x33\xfb\xd6\xeb\x4b\x16\xaa\x76\x13\xb3\xd0\x54\xae\x7a\x20\x34\x71\x68\x25\x85\xa4\x3a\x28\x8b\x5a\x7a\x1b\xda\x71\x5b\x3b\xd5\xfc\xd7\x32\x16\xff\x77\xdd\xbc\x8a\x2b\xac\x87\xb7\xd4\x33\x5a\x4d\x0f\xeb\x0f\xc1\x52\x14\x5e\x86\x11\x54\xb4\x19\xe0\x86\x82\x6d\xbe\xf1\xd2\x1c\x45\x3d\xda\x52\x6b\xaf\xb5\x9f\xba\xfc\x5f\x3d\x40\x29\x47\x1e\x55\x00\x00")
 
 func staticJavascriptsApplicationJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -328,7 +328,7 @@ func staticJavascriptsApplicationJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/javascripts/application.js", size: 13143, mode: os.FileMode(420), modTime: time.Unix(1528532866, 0)}
+	info := bindataFileInfo{name: "static/javascripts/application.js", size: 21790, mode: os.FileMode(420), modTime: time.Unix(1528532866, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -513,7 +513,7 @@ func staticJavascriptsUnderscoreJs() (*asset, error) {
 	return a, nil
 }
 
-var _staticStylesheetsApplicationCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xed\x8a\xe3\x38\x10\xfc\x9f\xa7\xd0\x11\x0e\xee\xe0\x64\xec\x64\x72\xb9\xf3\xfc\x5c\xd8\x97\x58\x86\xd0\x96\xda\x76\x33\xb2\x24\xa4\xce\xc7\xec\x32\xef\xbe\x48\x71\x32\xce\x26\xd9\xec\x12\x02\x46\xaa\xea\x8f\x52\x75\x83\xf8\x36\x13\x42\x39\xe3\x42\x2d\xc8\xf6\x18\x88\x9f\x67\x42\x30\x1e\x58\x6a\x54\x2e\x00\x93\xb3\xb5\xd8\x5a\x8d\xc1\x90\xc5\xe7\xd9\xfb\x6c\x06\x75\xef\x76\x18\x6e\x92\xd3\x75\xd1\xb0\xcd\x97\x57\x71\xac\x1b\x43\x28\xa7\xf1\x1e\xbf\x75\x8e\xc7\xe8\x8d\x0b\x1a\x83\x64\xe7\x6b\x51\xf9\x83\x88\xce\x90\x16\xf3\x65\x99\x7e\xa9\xd2\x01\x42\x47\xf6\x08\x58\x95\xfe\x90\xce\x3c\x68\x4d\xb6\xab\xc5\xa2\xf4\x07\x91\xfe\x55\x39\x7e\xa5\xeb\xd6\x59\x96\x91\xbe\x62\x2d\xaa\x2a\x1d\xbd\xcf\x66\x85\x85\x5d\x03\x41\xc0\x83\xb2\xcf\xb8\x89\x02\x0f\xc4\x2a\x7c\x70\x5d\xc0\x18\x65\x22\x9e\x09\x89\xde\x1a\xb7\xaf\x05\x1a\x43\x3e\x52\x4c\xb5\xed\x7b\x62\x94\xd1\x83\xc2\x94\x75\x1f\xc0\xa7\xe3\x0f\x70\x4f\x5a\xa3\xcd\x81\xe7\x2d\xd9\xd4\x67\xdc\x44\x84\xa0\xfa\x1c\x7b\x4f\x9a\xfb\x5a\x2c\xfe\x2d\xc7\xce\xe6\x0c\x8d\xc1\xcd\x09\x2b\x58\x17\xca\x19\xe9\x81\xfb\xa9\xfe\x73\xa5\xd4\x43\x7c\xe4\xe0\x6c\x77\x41\x6b\xdb\xf6\x26\x2d\x93\x40\x25\x41\xa6\x75\xad\xee\x95\x35\xc5\x17\x0d\xe8\x0e\xa7\xb4\xaa\x2c\xff\xbc\x4f\x53\x6e\x18\x88\xa7\xf8\xf5\xf8\xd4\x59\x69\x30\xd4\xd9\x5a\x04\xea\x7a\xbe\x1f\x24\xa0\x77\x91\xd8\x85\xb7\x0b\x1d\xcb\xdf\x8a\xc4\xa1\x60\x8c\x2c\x03\x1a\x60\xd4\x39\x92\xf3\xa0\x88\xdf\x6a\x51\x16\x4f\x47\x43\x44\x4f\xd6\x8e\xe6\xd1\x14\xbd\x81\xb7\x5a\x34\xc6\xa9\xd7\x0f\x47\xd7\x62\xb1\xf2\x07\x01\x5b\x76\xa2\x2a\xc7\xaf\x4c\x4f\x39\x52\x56\x19\xd1\xa0\x3a\x65\x69\x40\xbd\x76\xc1\x6d\xad\x96\xa7\xa7\x59\xae\x57\xb0\x6e\xc5\x1f\x34\x78\x17\x18\xec\x58\xf2\xe0\x34\x98\x4d\x4b\x06\x45\x01\x06\x03\xcb\x88\xca\x59\x0d\x63\xe7\x13\x43\x3c\xc4\xff\xc4\x10\xc5\x28\x8a\x1c\x90\x41\xe6\x8a\x33\x6e\x3a\x7d\xcb\xd3\xf4\xdd\xc0\x8e\xf6\x1c\x67\x59\x66\xcd\xeb\x2c\xc5\x85\xf9\x37\xa4\x37\xca\x90\x6f\x1c\x84\xa3\x12\x1c\xc0\xc6\xd6\x85\xa1\x16\x51\x81\xc1\xbf\xca\x62\xfd\xf7\x8f\xad\x6f\x94\xb3\x8c\x96\x63\xfe\x00\xba\x7a\x8e\xf3\xc4\xdf\x22\xfd\x23\xa6\xa7\x3d\x1e\xf4\x76\xf0\xbf\xc0\x9f\x22\x07\x38\xc8\x1e\x8f\x5d\x3d\x95\xe7\xb6\x6e\xa0\xe7\x69\x95\x48\xbb\x1d\x9a\xcb\x95\x3b\x2f\xcb\x46\xfd\xa7\xee\xf2\xa2\x07\xfb\x45\x03\x83\x8c\x1c\x92\x86\xa4\x5f\x6e\x57\x7e\x85\xb4\x5b\x63\x5e\x2e\x72\x7d\x5e\xfe\xff\xa9\x5a\x24\x7f\xee\x30\x30\x29\x30\xa7\x69\x18\x48\x6b\x83\x79\xef\xa6\x01\xca\x1b\x30\xfb\x9f\x76\xf9\xf4\x2c\x09\xd9\xdc\xc8\xd9\xe8\xd7\x4b\x4d\x88\x93\x22\xd5\xea\x38\x76\xa7\xf9\x7f\xba\x9e\x42\x85\x96\x31\xa4\xee\xbf\x07\x00\x00\xff\xff\x41\xd0\x88\x66\xc6\x06\x00\x00")
+var _staticStylesheetsApplicationCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x02\xff\x95\x55\xeb\x6a\xdb\x30\x14\xfe\x9f\xa7\xd0\x08\x83\x0d\x66\xe3\x34\x4d\xd3\xb9\x3f\x07\x7b\x89\x51\xc2\xb1\x24\xdb\xa2\xb2\x24\xa4\x93\xcb\x36\xf6\xee\x3b\x52\x9c\xd4\x69\x9c\x7a\xa3\x14\xcc\xd1\xf7\x9d\xcb\x77\x2e\x01\xf6\x7b\xc6\x18\xb7\xda\xfa\x92\x29\xd3\x4a\xaf\xf0\x89\x2c\x28\x0f\x98\x09\xc9\xad\x07\x54\xd6\x94\x6c\x6b\x84\xf4\x5a\x19\xf9\x34\xfb\x33\x9b\x41\xd9\xda\x9d\xf4\xa3\xe4\xf8\x9c\x57\x68\xd2\xe3\x95\x1f\x63\x7b\x17\xdc\x0a\x79\x8b\x5f\x5b\x8b\xbd\xf7\xca\x7a\x0a\x9c\xa1\x75\x25\x5b\xb8\x03\x0b\x56\x2b\xc1\xe6\xcb\x22\xfe\xc5\x4c\x3b\xf0\x8d\x32\x47\xc0\xaa\x70\x87\x68\x73\x20\x84\x32\x4d\xc9\xee\xc8\xc0\xe2\xff\xa2\xe8\xbf\xe2\x73\x6d\x0d\x66\x41\xfd\x92\xe4\x72\x11\x4d\x14\x32\x37\xb0\xab\xc0\x33\x98\x48\xfb\x8c\x1b\x28\x30\x21\x56\xee\xbc\x6d\xbc\x0c\x21\x8b\xc4\x33\x21\xd2\x6b\x6d\xf7\x25\x93\x5a\x2b\x17\x54\x88\xb9\xed\x5b\x85\x32\x0b\x0e\xb8\x8c\x51\xf7\x1e\x5c\x34\xbf\x82\x5b\x25\x84\x34\xc9\xf1\xbc\x56\x26\xd6\x19\x36\x41\x82\xe7\x6d\xf2\xbd\x57\x02\x5b\xaa\xfc\xa1\xe8\x2b\x1b\xa2\x76\x51\xe2\x9f\x43\xdc\xe2\xa1\x17\xa5\xd7\xd1\xab\xa6\x45\x32\x5f\xb3\x85\xaa\xeb\x0b\xe6\xe3\x04\x13\xa1\xd2\x72\x73\xe2\x33\x14\x39\xb5\x3a\x73\x80\xed\xb0\xef\x73\xce\xf9\x24\x3e\xa0\xb7\xa6\xb9\xa0\xd5\x75\x3d\x49\xcb\xad\x16\xaf\x01\x2d\x89\x4a\xd5\x97\xac\xc8\x1f\x46\xa9\x89\x08\x3c\xf6\xf0\x0b\x1b\x7d\x1c\x53\x70\x75\xab\xe0\x81\x3b\x96\x57\x20\x1a\x39\xe5\xf5\x88\xba\x10\xb9\x28\x3e\xde\x76\xce\x6d\xd7\x29\x1c\xe2\xd7\x7d\x4f\xd2\x84\x81\x56\x0d\x4d\x63\x6a\xcc\x6d\x27\x5e\x3a\x1b\x14\x5a\x7f\x51\xd5\x5d\xf1\x5f\x9e\xd0\xe7\x28\x03\x92\x33\x0d\x28\xc5\x5b\xbd\xef\x8f\x8b\x10\x9c\x32\xa6\x5f\x1a\xa1\x82\xd3\x40\xaf\x95\xb6\xfc\xe5\x75\x8e\x28\xf4\x8a\x56\x15\xb6\x68\xd3\x2c\xa5\xaf\x44\x8f\x31\x62\x54\xd2\x4b\x4b\x7e\x8a\x52\x01\x7f\x69\xbc\xa5\x95\xcb\x4e\xa3\xb1\x5c\xaf\x60\x5d\xb3\x0f\xaa\x73\xd6\x23\x98\x3e\xe5\xce\x0a\xd0\x94\xb2\x96\x2c\x07\x2d\x3d\xdd\x00\xda\x59\x23\xa0\xaf\x7c\x30\x90\x93\xf8\x77\x06\x32\xef\x45\xc9\x3a\x89\x90\xa5\x8c\x13\x6e\x78\x75\x96\xa7\xab\x33\x82\xed\xa7\xb5\xbf\x61\x37\x17\x72\xa3\xc4\x86\xd3\xdd\xa8\x2c\xf8\xa3\x12\xe8\xc1\x84\xda\xfa\xae\x64\x81\x53\xc2\x9f\x8a\x7c\xfd\xf9\x6d\xe9\x1b\xaa\x00\xa5\xc1\x90\x3e\x40\x5d\xb5\xe3\x7c\xe9\xc6\x48\x34\xc0\x03\x6b\x2b\x0f\x62\xdb\xb9\x7f\xe0\x0f\x91\x1d\x1c\xb2\x56\x1e\xab\xba\x2f\xce\x65\x8d\xa0\xe7\xf1\x84\x66\x66\xdb\x55\x97\x3f\x35\xf3\xa2\xa8\xf8\x23\xbf\xc9\xa3\xeb\x69\x7e\x08\x20\x45\xa9\x4d\x51\x43\x25\x9e\xc7\x33\xbf\x42\x9a\xad\xd6\xcf\x17\xb1\xbe\x2f\xbf\x7e\x5b\xdc\xc5\xf9\xa4\x25\x45\x45\xc2\x9e\xb6\xa1\xa3\x4b\xac\x65\xfa\xbd\x89\x0b\x94\x2e\x7f\x9a\x7f\xb5\x4b\xd6\xb3\x24\xca\xa4\x42\xce\x83\x7e\x7d\xcc\x19\x3b\x29\xb2\x58\x1d\xd7\xee\xb4\xff\xf7\xd7\x5b\xc8\xa9\x13\xd2\xc7\xea\xff\x02\x73\xe3\x5b\x12\xbe\x07\x00\x00")
 
 func staticStylesheetsApplicationCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -528,7 +528,7 @@ func staticStylesheetsApplicationCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/stylesheets/application.css", size: 1734, mode: os.FileMode(420), modTime: time.Unix(1528532866, 0)}
+	info := bindataFileInfo{name: "static/stylesheets/application.css", size: 1982, mode: os.FileMode(420), modTime: time.Unix(1528532866, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -31,3 +31,12 @@ func TruncateString(str string, maxLength int) string {
   }
   return str
 }
+
+func RedactString(str string, start int, end int) string {
+  secret := str[start:end]
+  visible := len(secret) / 4
+  if visible > 4 {
+    visible = 4
+  }
+  return str[:start] + secret[:visible] + strings.Repeat("*", len(secret)-visible) + str[end:]
+}
@@ -0,0 +1,82 @@
+package core
+
+// SessionDiff holds the changes between a previous and a current session.
+// Findings are compared by ID, and targets and repositories by their ID.
+type SessionDiff struct {
+  Previous            *Stats
+  NewFindings         []*Finding
+  ResolvedFindings    []*Finding
+  UnchangedFindings   []*Finding
+  NewTargets          []*Owner
+  RemovedTargets      []*Owner
+  NewRepositories     []*Repository
+  RemovedRepositories []*Repository
+}
+
+// LoadSession reads a session file saved with -save for comparison.
+func LoadSession(location string) (*Session, error) {
+  var session Session
+  if err := session.loadFromFile(location); err != nil {
+    return nil, err
+  }
+  return &session, nil
+}
+
+func DiffSessions(previous *Session, current *Session) *SessionDiff {
+  diff := &SessionDiff{Previous: previous.Stats}
+
+  previousFindings := make(map[string]bool)
+  for _, f := range previous.Findings {
+    previousFindings[f.Id] = true
+  }
+  currentFindings := make(map[string]bool)
+  for _, f := range current.Findings {
+    currentFindings[f.Id] = true
+    if previousFindings[f.Id] {
+      diff.UnchangedFindings = append(diff.UnchangedFindings, f)
+    } else {
+      diff.NewFindings = append(diff.NewFindings, f)
+    }
+  }
+  for _, f := range previous.Findings {
+    if !currentFindings[f.Id] {
+      diff.ResolvedFindings = append(diff.ResolvedFindings, f)
+    }
+  }
+
+  previousTargets := make(map[int64]bool)
+  for _, t := range previous.Targets {
+    previousTargets[*t.ID] = true
+  }
+  currentTargets := make(map[int64]bool)
+  for _, t := range current.Targets {
+    currentTargets[*t.ID] = true
+    if !previousTargets[*t.ID] {
+      diff.NewTargets = append(diff.NewTargets, t)
+    }
+  }
+  for _, t := range previous.Targets {
+    if !currentTargets[*t.ID] {
+      diff.RemovedTargets = append(diff.RemovedTargets, t)
+    }
+  }
+
+  previousRepositories := make(map[int64]bool)
+  for _, r := range previous.Repositories {
+    previousRepositories[*r.ID] = true
+  }
+  currentRepositories := make(map[int64]bool)
+  for _, r := range current.Repositories {
+    currentRepositories[*r.ID] = true
+    if !previousRepositories[*r.ID] {
+      diff.NewRepositories = append(diff.NewRepositories, r)
+    }
+  }
+  for _, r := range previous.Repositories {
+    if !currentRepositories[*r.ID] {
+      diff.RemovedRepositories = append(diff.RemovedRepositories, r)
+    }
+  }
+
+  return diff
+}
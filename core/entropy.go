@@ -0,0 +1,110 @@
+package core
+
+import (
+  "math"
+  "regexp"
+)
+
+const (
+  DefaultEntropyThreshold = 4.5
+)
+
+var (
+  base64TokenRegex = regexp.MustCompile(`[A-Za-z0-9+/=_\-]{20,}`)
+  hexTokenRegex    = regexp.MustCompile(`[0-9a-fA-F]{20,}`)
+)
+
+type EntropySignature struct {
+  id          string
+  tokens      *regexp.Regexp
+  threshold   float64
+  description string
+  comment     string
+}
+
+// NewEntropySignatures returns detectors for high entropy base64 and hex
+// strings. The threshold is given for base64 strings and scaled down for hex
+// strings, which can carry at most 4 bits of entropy per character.
+func NewEntropySignatures(threshold float64) []Signature {
+  return []Signature{
+    EntropySignature{
+      id:          "high-entropy-base64",
+      tokens:      base64TokenRegex,
+      threshold:   threshold,
+      description: "High entropy base64 string",
+      comment:     "Random looking strings are often API keys, tokens or passwords",
+    },
+    EntropySignature{
+      id:          "high-entropy-hex",
+      tokens:      hexTokenRegex,
+      threshold:   threshold * 4 / 6,
+      description: "High entropy hex string",
+      comment:     "Random looking strings are often API keys, tokens or passwords",
+    },
+  }
+}
+
+func ShannonEntropy(str string) float64 {
+  if str == "" {
+    return 0
+  }
+  frequencies := make(map[rune]float64)
+  for _, r := range str {
+    frequencies[r]++
+  }
+  var entropy float64
+  length := float64(len(str))
+  for _, count := range frequencies {
+    p := count / length
+    entropy -= p * math.Log2(p)
+  }
+  return entropy
+}
+
+func (s EntropySignature) Match(file MatchFile) bool {
+  return s.FindMatch(file) != nil
+}
+
+func (s EntropySignature) FindMatch(file MatchFile) *ContentMatch {
+  for _, line := range file.Additions {
+    for _, loc := range s.tokens.FindAllStringIndex(line.Content, -1) {
+      token := line.Content[loc[0]:loc[1]]
+      if ShannonEntropy(token) < s.threshold {
+        continue
+      }
+      return &ContentMatch{
+        LineNumber: line.LineNumber,
+        Snippet:    TruncateString(RedactString(line.Content, loc[0], loc[1]), 100),
+      }
+    }
+  }
+  return nil
+}
+
+func (s EntropySignature) ID() string {
+  return s.id
+}
+
+func (s EntropySignature) Description() string {
+  return s.description
+}
+
+func (s EntropySignature) Comment() string {
+  return s.comment
+}
+
+func (s EntropySignature) Severity() string {
+  return SeverityMedium
+}
+
+func (s EntropySignature) Confidence() string {
+  return ConfidenceLow
+}
+
+func (s EntropySignature) Category() string {
+  return CategoryTokens
+}
+
+func (s EntropySignature) References() []string {
+  return nil
+}
@@ -0,0 +1,179 @@
+package core
+
+import (
+  "encoding/csv"
+  "encoding/json"
+  "errors"
+  "fmt"
+  "io"
+  "os"
+  "path/filepath"
+  "reflect"
+  "strconv"
+  "strings"
+  "time"
+)
+
+const (
+  ExportFormatSarif  = "sarif"
+  ExportFormatCsv    = "csv"
+  ExportFormatNdjson = "ndjson"
+)
+
+var exportFormatExtensions = map[string]string{
+  ".sarif":  ExportFormatSarif,
+  ".csv":    ExportFormatCsv,
+  ".ndjson": ExportFormatNdjson,
+  ".jsonl":  ExportFormatNdjson,
+}
+
+// ExportFormat returns the export format to use for location, which is the
+// given format or otherwise derived from the file extension.
+func ExportFormat(format string, location string) (string, error) {
+  if format == "" {
+    format = exportFormatExtensions[strings.ToLower(filepath.Ext(location))]
+    if format == "" {
+      return "", errors.New(fmt.Sprintf("can't determine export format from %s, please specify one with -export-format", location))
+    }
+  }
+  switch format {
+  case ExportFormatSarif, ExportFormatCsv, ExportFormatNdjson:
+    return format, nil
+  }
+  return "", errors.New(fmt.Sprintf("unknown export format: %q", format))
+}
+
+// ExportTablePath returns the path of an additional table exported next to
+// location, e.g. findings-targets.csv for findings.csv.
+func ExportTablePath(location string, table string) string {
+  ext := filepath.Ext(location)
+  return fmt.Sprintf("%s-%s%s", strings.TrimSuffix(location, ext), table, ext)
+}
+
+// Export writes the findings of the session to location in format. The
+// csv and ndjson formats can also write the targets and repositories to
+// their own files next to it.
+func (s *Session) Export(format string, location string, tables bool) error {
+  s.Lock()
+  defer s.Unlock()
+  if format == ExportFormatSarif {
+    return exportFile(location, func(w io.Writer) error {
+      return WriteSarif(w, s.Findings, s.Signatures)
+    })
+  }
+  if err := exportTable(format, location, s.Findings); err != nil {
+    return err
+  }
+  if !tables {
+    return nil
+  }
+  if err := exportTable(format, ExportTablePath(location, "targets"), s.Targets); err != nil {
+    return err
+  }
+  return exportTable(format, ExportTablePath(location, "repositories"), s.Repositories)
+}
+
+func exportTable(format string, location string, records interface{}) error {
+  return exportFile(location, func(w io.Writer) error {
+    if format == ExportFormatCsv {
+      return WriteCsv(w, records)
+    }
+    return WriteNdjson(w, records)
+  })
+}
+
+func exportFile(location string, write func(w io.Writer) error) error {
+  file, err := os.Create(location)
+  if err != nil {
+    return err
+  }
+  if err := write(file); err != nil {
+    file.Close()
+    return err
+  }
+  return file.Close()
+}
+
+// WriteNdjson writes a slice of records as one JSON object per line.
+func WriteNdjson(w io.Writer, records interface{}) error {
+  encoder := json.NewEncoder(w)
+  values := reflect.ValueOf(records)
+  for i := 0; i < values.Len(); i++ {
+    if err := encoder.Encode(values.Index(i).Interface()); err != nil {
+      return err
+    }
+  }
+  return nil
+}
+
+// WriteCsv writes a slice of struct pointers as a table with a column for
+// each exported field. Lists are joined with spaces, and cells that a
+// spreadsheet would interpret as a formula are prefixed with a quote.
+func WriteCsv(w io.Writer, records interface{}) error {
+  writer := csv.NewWriter(w)
+  values := reflect.ValueOf(records)
+  recordType := values.Type().Elem().Elem()
+  var header []string
+  var fields []int
+  for i := 0; i < recordType.NumField(); i++ {
+    field := recordType.Field(i)
+    if field.PkgPath != "" || field.Anonymous || field.Tag.Get("json") == "-" {
+      continue
+    }
+    header = append(header, field.Name)
+    fields = append(fields, i)
+  }
+  if err := writer.Write(header); err != nil {
+    return err
+  }
+  for i := 0; i < values.Len(); i++ {
+    record := values.Index(i).Elem()
+    row := make([]string, len(fields))
+    for j, field := range fields {
+      row[j] = csvCell(record.Field(field))
+    }
+    if err := writer.Write(row); err != nil {
+      return err
+    }
+  }
+  writer.Flush()
+  return writer.Error()
+}
+
+func csvCell(value reflect.Value) string {
+  if value.Kind() == reflect.Ptr {
+    if value.IsNil() {
+      return ""
+    }
+    value = value.Elem()
+  }
+  if date, ok := value.Interface().(time.Time); ok {
+    if date.IsZero() {
+      return ""
+    }
+    return date.Format(time.RFC3339)
+  }
+  var cell string
+  switch value.Kind() {
+  case reflect.String:
+    cell = value.String()
+  case reflect.Int, reflect.Int64:
+    return strconv.FormatInt(value.Int(), 10)
+  case reflect.Float64:
+    return strconv.FormatFloat(value.Float(), 'f', -1, 64)
+  case reflect.Bool:
+    return strconv.FormatBool(value.Bool())
+  case reflect.Slice:
+    var items []string
+    for i := 0; i < value.Len(); i++ {
+      items = append(items, csvCell(value.Index(i)))
+    }
+    cell = strings.Join(items, " ")
+  default:
+    cell = fmt.Sprintf("%v", value.Interface())
+  }
+  if cell != "" && strings.ContainsAny(cell[:1], "=+-@\t\r") {
+    cell = "'" + cell
+  }
+  return cell
+}
@@ -1,22 +1,52 @@
 package core
 
 import (
+  "container/heap"
+  "errors"
   "fmt"
   "io/ioutil"
+  "sort"
+  "strings"
 
+  "github.com/sergi/go-diff/diffmatchpatch"
   "gopkg.in/src-d/go-git.v4"
+  "gopkg.in/src-d/go-git.v4/config"
   "gopkg.in/src-d/go-git.v4/plumbing"
+  "gopkg.in/src-d/go-git.v4/plumbing/format/diff"
   "gopkg.in/src-d/go-git.v4/plumbing/object"
+  "gopkg.in/src-d/go-git.v4/plumbing/storer"
+  "gopkg.in/src-d/go-git.v4/plumbing/transport"
+  utildiff "gopkg.in/src-d/go-git.v4/utils/diff"
   "gopkg.in/src-d/go-git.v4/utils/merkletrie"
 )
 
 const (
-  EmptyTreeCommitId = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+  UpstreamRemoteName = "gitrob-upstream"
+  RenameSimilarity   = 50
+  RenameLimit        = 100
 )
 
-func CloneRepository(url *string, branch *string, depth int) (*git.Repository, string, error) {
+var (
+  ErrParentNotFound = errors.New("parent commit not found, the repository is probably a shallow clone")
+  emptyBlobHash     = plumbing.NewHash("e69de29bb2d1d6434b8b29ae775ad8c2e48c5391")
+)
+
+// CloneRepository clones the default branch of a repository to a temporary
+// directory, along with all other branches and tags if requested. The branch
+// the remote HEAD points to is cloned if branch is empty, which also fetches
+// the other branches since a single branch clone of HEAD expects a master
+// branch to exist.
+func CloneRepository(url *string, branch *string, depth int, allBranches bool, tags bool, auth transport.AuthMethod) (*git.Repository, string, error) {
   urlVal := *url
   branchVal := *branch
+  tagMode := git.NoTags
+  if tags {
+    tagMode = git.AllTags
+  }
+  referenceName := plumbing.HEAD
+  if branchVal != "" {
+    referenceName = plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", branchVal))
+  }
   dir, err := ioutil.TempDir("", "gitrob")
   if err != nil {
     return nil, "", err
@@ -24,9 +54,10 @@ func CloneRepository(url *string, branch *string, depth int) (*git.Repository, s
   repository, err := git.PlainClone(dir, false, &git.CloneOptions{
     URL:           urlVal,
     Depth:         depth,
-    ReferenceName: plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", branchVal)),
-    SingleBranch:  true,
-    Tags:          git.NoTags,
+    ReferenceName: referenceName,
+    SingleBranch:  !allBranches && branchVal != "",
+    Tags:          tagMode,
+    Auth:          auth,
   })
   if err != nil {
     return nil, dir, err
@@ -34,59 +65,534 @@ func CloneRepository(url *string, branch *string, depth int) (*git.Repository, s
   return repository, dir, nil
 }
 
-func GetRepositoryHistory(repository *git.Repository) ([]*object.Commit, error) {
-  var commits []*object.Commit
-  ref, err := repository.Head()
+// IsMissingRepository reports whether cloning failed because the remote
+// repository doesn't exist. Git hosts answer requests for repositories that
+// don't exist or aren't accessible as if authentication is required.
+func IsMissingRepository(err error) bool {
+  return err == transport.ErrRepositoryNotFound || err == transport.ErrAuthenticationRequired
+}
+
+// FetchUpstream fetches the default branch of the repository that a fork was
+// created from, and its branches with the same names as branches of the fork,
+// so that GetRepositoryHistory can leave out the commits the fork inherited
+// from it. The commits of the fork are sent as haves, so only the upstream
+// history that the fork doesn't have is downloaded, however far a stale fork
+// is behind.
+func FetchUpstream(repository *git.Repository, url string, auth transport.AuthMethod) error {
+  remote, err := repository.CreateRemote(&config.RemoteConfig{
+    Name: UpstreamRemoteName,
+    URLs: []string{url},
+  })
   if err != nil {
-    return nil, err
+    return err
   }
-  cIter, err := repository.Log(&git.LogOptions{From: ref.Hash()})
+  upstreamRefs, err := remote.List(&git.ListOptions{Auth: auth})
   if err != nil {
-    return nil, err
+    return err
+  }
+  branches, err := getBranchNames(repository)
+  if err != nil {
+    return err
+  }
+  fetch := make(map[plumbing.ReferenceName]bool)
+  for _, ref := range upstreamRefs {
+    switch {
+    case ref.Name() == plumbing.HEAD && ref.Type() == plumbing.SymbolicReference:
+      fetch[ref.Target()] = true
+    case ref.Name().IsBranch() && branches[ref.Name().Short()]:
+      fetch[ref.Name()] = true
+    }
+  }
+  var refSpecs []config.RefSpec
+  for name := range fetch {
+    refSpecs = append(refSpecs, config.RefSpec(fmt.Sprintf("+%s:refs/remotes/%s/%s", name, UpstreamRemoteName, name.Short())))
   }
-  cIter.ForEach(func(c *object.Commit) error {
-    commits = append(commits, c)
+  if len(refSpecs) == 0 {
     return nil
+  }
+  err = remote.Fetch(&git.FetchOptions{
+    RemoteName: UpstreamRemoteName,
+    RefSpecs:   refSpecs,
+    Tags:       git.NoTags,
+    Auth:       auth,
   })
-  return commits, nil
+  if err != nil && err != git.NoErrAlreadyUpToDate {
+    return err
+  }
+  return nil
+}
+
+func OpenRepository(path *string) (*git.Repository, error) {
+  return git.PlainOpen(*path)
+}
+
+// History is the set of commits to analyze in a repository, along with the
+// names of the refs that each commit can be reached from.
+type History struct {
+  Commits []*object.Commit
+  Refs    map[plumbing.Hash][]string
 }
 
-func GetChanges(commit *object.Commit, repo *git.Repository) (object.Changes, error) {
-  parentCommit, err := GetParentCommit(commit, repo)
+// GetRepositoryHistory returns up to depth commits from HEAD and, if
+// requested, from every local and remote branch and every tag. Commits that
+// are reachable from more than one ref are only included once, and commits
+// fetched from an upstream repository with FetchUpstream are left out. The
+// history of shallow clones ends at the first missing commit.
+func GetRepositoryHistory(repository *git.Repository, depth int, allBranches bool, tags bool) (*History, error) {
+  history := &History{Refs: make(map[plumbing.Hash][]string)}
+  refs, err := getHistoryRefs(repository, allBranches, tags)
   if err != nil {
     return nil, err
   }
+  if len(refs) == 0 {
+    return nil, plumbing.ErrReferenceNotFound
+  }
+  upstream, err := getUpstreamCommits(repository, refs)
+  if err != nil {
+    return nil, err
+  }
+  for _, ref := range refs {
+    name := refDisplayName(ref.Name())
+    count := 0
+    visit := func(c *object.Commit) error {
+      if count >= depth {
+        return storer.ErrStop
+      }
+      count++
+      names, seen := history.Refs[c.Hash]
+      if !seen {
+        history.Commits = append(history.Commits, c)
+      }
+      for _, existing := range names {
+        if existing == name {
+          return nil
+        }
+      }
+      history.Refs[c.Hash] = append(names, name)
+      return nil
+    }
+    if upstream != nil {
+      seen := make(map[plumbing.Hash]bool, len(upstream))
+      for hash := range upstream {
+        seen[hash] = true
+      }
+      err = walkCommits(repository, ref.Hash(), seen, visit)
+    } else {
+      var cIter object.CommitIter
+      if cIter, err = repository.Log(&git.LogOptions{From: ref.Hash()}); err != nil {
+        return nil, err
+      }
+      err = cIter.ForEach(visit)
+      cIter.Close()
+    }
+    if err != nil && err != plumbing.ErrObjectNotFound {
+      return nil, err
+    }
+  }
+  return history, nil
+}
 
-  commitTree, err := commit.Tree()
+// getHistoryRefs returns HEAD followed by the branches and tags to analyze,
+// with annotated tags peeled to the commits they point to. Tags of other
+// objects, such as trees or blobs, are left out.
+func getHistoryRefs(repository *git.Repository, allBranches bool, tags bool) ([]*plumbing.Reference, error) {
+  var refs []*plumbing.Reference
+  head, err := repository.Head()
+  if err == nil {
+    refs = append(refs, head)
+  } else if err != plumbing.ErrReferenceNotFound {
+    return nil, err
+  }
+  if !allBranches && !tags {
+    return refs, nil
+  }
+  iter, err := repository.References()
+  if err != nil {
+    return nil, err
+  }
+  err = iter.ForEach(func(ref *plumbing.Reference) error {
+    if ref.Type() != plumbing.HashReference {
+      return nil
+    }
+    name := ref.Name()
+    if isUpstreamRef(name) {
+      return nil
+    }
+    if allBranches && (name.IsBranch() || name.IsRemote()) {
+      refs = append(refs, ref)
+      return nil
+    }
+    if !tags || !name.IsTag() {
+      return nil
+    }
+    tag, err := repository.TagObject(ref.Hash())
+    if err == plumbing.ErrObjectNotFound {
+      if _, err := repository.CommitObject(ref.Hash()); err == nil {
+        refs = append(refs, ref)
+      }
+      return nil
+    }
+    if err != nil {
+      return err
+    }
+    commit, err := tag.Commit()
+    if err == object.ErrUnsupportedObject {
+      return nil
+    }
+    if err != nil {
+      return err
+    }
+    refs = append(refs, plumbing.NewHashReference(name, commit.Hash))
+    return nil
+  })
   if err != nil {
     return nil, err
   }
+  return refs, nil
+}
 
-  parentCommitTree, err := parentCommit.Tree()
+// getUpstreamCommits returns the commits fetched with FetchUpstream where
+// the history of refs joins the upstream history, or nil if no upstream was
+// fetched. Walking the history of refs up to these commits leaves out
+// everything the fork inherited, and the upstream history is only walked
+// back to where the fork diverged from it.
+func getUpstreamCommits(repository *git.Repository, refs []*plumbing.Reference) (map[plumbing.Hash]bool, error) {
+  walk := newCommitWalk(repository)
+  iter, err := repository.References()
   if err != nil {
     return nil, err
   }
+  found := false
+  err = iter.ForEach(func(ref *plumbing.Reference) error {
+    if ref.Type() != plumbing.HashReference || !isUpstreamRef(ref.Name()) {
+      return nil
+    }
+    found = true
+    return walk.add(ref.Hash(), true)
+  })
+  if err != nil || !found {
+    return nil, err
+  }
+  for _, ref := range refs {
+    if err := walk.add(ref.Hash(), false); err != nil {
+      return nil, err
+    }
+  }
+  if _, err := walk.run(); err != nil {
+    return nil, err
+  }
+  return walk.known, nil
+}
 
-  changes, err := object.DiffTree(parentCommitTree, commitTree)
+// getBranchNames returns the names of the local branches of a repository and
+// of the branches of its origin remote.
+func getBranchNames(repository *git.Repository) (map[string]bool, error) {
+  names := make(map[string]bool)
+  iter, err := repository.References()
   if err != nil {
     return nil, err
   }
-  return changes, nil
+  err = iter.ForEach(func(ref *plumbing.Reference) error {
+    name := ref.Name()
+    if name.IsBranch() {
+      names[name.Short()] = true
+    } else if strings.HasPrefix(name.String(), "refs/remotes/origin/") && name != "refs/remotes/origin/HEAD" {
+      names[strings.TrimPrefix(name.String(), "refs/remotes/origin/")] = true
+    }
+    return nil
+  })
+  if err != nil {
+    return nil, err
+  }
+  return names, nil
 }
 
-func GetParentCommit(commit *object.Commit, repo *git.Repository) (*object.Commit, error) {
+func isUpstreamRef(name plumbing.ReferenceName) bool {
+  return strings.HasPrefix(name.String(), fmt.Sprintf("refs/remotes/%s/", UpstreamRemoteName))
+}
+
+// refDisplayName returns the short name of a ref, without the origin/ prefix
+// of remote branches since they're the same branches as seen on the provider.
+func refDisplayName(name plumbing.ReferenceName) string {
+  if name == plumbing.HEAD {
+    return "HEAD"
+  }
+  return strings.TrimPrefix(name.Short(), "origin/")
+}
+
+// Change is a changed file in a commit. Renamed and copied files are a single
+// change from the old path to the new one.
+type Change struct {
+  *object.Change
+  Action  string
+  OldPath string
+}
+
+// GetChanges returns the changes introduced by a commit. Root commits are
+// compared with an empty tree, so every file they contain is an addition.
+// Merge commits are compared with their first parent, leaving out changes
+// that match one of the other parents since those were introduced by the
+// merged commits themselves. What remains are conflict resolutions and other
+// changes made in the merge. ErrParentNotFound is returned for commits at the
+// boundary of a shallow clone. Renamed and copied files are detected as
+// described for detectRenames.
+func GetChanges(commit *object.Commit, repo *git.Repository) ([]*Change, error) {
+  commitTree, err := commit.Tree()
+  if err != nil {
+    return nil, err
+  }
   if commit.NumParents() == 0 {
-    parentCommit, err := repo.CommitObject(plumbing.NewHash(EmptyTreeCommitId))
+    changes, err := object.DiffTree(nil, commitTree)
     if err != nil {
       return nil, err
     }
-    return parentCommit, nil
+    return detectRenames(changes)
   }
-  parentCommit, err := commit.Parents().Next()
+
+  var parentTrees []*object.Tree
+  for _, hash := range commit.ParentHashes {
+    parent, err := repo.CommitObject(hash)
+    if err == plumbing.ErrObjectNotFound {
+      return nil, ErrParentNotFound
+    }
+    if err != nil {
+      return nil, err
+    }
+    tree, err := parent.Tree()
+    if err != nil {
+      return nil, err
+    }
+    parentTrees = append(parentTrees, tree)
+  }
+
+  changes, err := object.DiffTree(parentTrees[0], commitTree)
   if err != nil {
     return nil, err
   }
-  return parentCommit, nil
+  if len(parentTrees) == 1 {
+    return detectRenames(changes)
+  }
+  var mergeChanges object.Changes
+  for _, change := range changes {
+    fromOtherParent := false
+    for _, tree := range parentTrees[1:] {
+      if isChangeInTree(change, tree) {
+        fromOtherParent = true
+        break
+      }
+    }
+    if !fromOtherParent {
+      mergeChanges = append(mergeChanges, change)
+    }
+  }
+  return detectRenames(mergeChanges)
+}
+
+// detectRenames pairs each added file with a deleted file that had the same
+// contents, turning them into a single rename. Added files with the same
+// contents as the previous version of a modified file become copies, the
+// same way git detects copies by default. Remaining added and deleted files
+// are paired into renames if their contents are similar enough, as
+// described for pairSimilarFiles. Empty files are never paired since they
+// have nothing in common but their emptiness.
+func detectRenames(changes object.Changes) ([]*Change, error) {
+  deleted := make(map[plumbing.Hash][]int)
+  modified := make(map[plumbing.Hash]object.ChangeEntry)
+  for i, change := range changes {
+    if change.To.Name == "" {
+      deleted[change.From.TreeEntry.Hash] = append(deleted[change.From.TreeEntry.Hash], i)
+    } else if change.From.Name != "" {
+      modified[change.From.TreeEntry.Hash] = change.From
+    }
+  }
+
+  renamedFrom := make(map[int]int)
+  copiedFrom := make(map[int]object.ChangeEntry)
+  var added []int
+  for i, change := range changes {
+    if change.From.Name != "" || change.To.TreeEntry.Hash == emptyBlobHash {
+      continue
+    }
+    hash := change.To.TreeEntry.Hash
+    if candidates := deleted[hash]; len(candidates) > 0 {
+      renamedFrom[i] = candidates[0]
+      deleted[hash] = candidates[1:]
+      continue
+    }
+    if from, ok := modified[hash]; ok {
+      copiedFrom[i] = from
+      continue
+    }
+    added = append(added, i)
+  }
+
+  if len(added) > 0 {
+    var remaining []int
+    for hash, candidates := range deleted {
+      if hash != emptyBlobHash {
+        remaining = append(remaining, candidates...)
+      }
+    }
+    sort.Ints(remaining)
+    addedFiles := make([]*object.File, len(added))
+    for i, index := range added {
+      _, file, err := changes[index].Files()
+      if err != nil {
+        return nil, err
+      }
+      addedFiles[i] = file
+    }
+    deletedFiles := make([]*object.File, len(remaining))
+    for i, index := range remaining {
+      file, _, err := changes[index].Files()
+      if err != nil {
+        return nil, err
+      }
+      deletedFiles[i] = file
+    }
+    pairs, err := pairSimilarFiles(addedFiles, deletedFiles)
+    if err != nil {
+      return nil, err
+    }
+    for to, from := range pairs {
+      renamedFrom[added[to]] = remaining[from]
+    }
+  }
+
+  renamed := make(map[int]bool)
+  for _, from := range renamedFrom {
+    renamed[from] = true
+  }
+  var result []*Change
+  for i, change := range changes {
+    if renamed[i] {
+      continue
+    }
+    if from, ok := renamedFrom[i]; ok {
+      result = append(result, &Change{
+        Change:  &object.Change{From: changes[from].From, To: change.To},
+        Action:  "Rename",
+        OldPath: changes[from].From.Name,
+      })
+      continue
+    }
+    if from, ok := copiedFrom[i]; ok {
+      result = append(result, &Change{
+        Change:  &object.Change{From: from, To: change.To},
+        Action:  "Copy",
+        OldPath: from.Name,
+      })
+      continue
+    }
+    result = append(result, &Change{Change: change, Action: GetChangeAction(change)})
+  }
+  return result, nil
+}
+
+// pairSimilarFiles pairs added files with deleted files that have at least
+// RenameSimilarity percent of their contents in common, best matches first,
+// and returns the index of the deleted file that each paired added file was
+// renamed from. Binary files and files larger than MaximumFileSize are only
+// ever renamed unchanged, and like git, nothing is paired when more than
+// RenameLimit files were added or deleted since comparing them all would
+// take too long.
+func pairSimilarFiles(added []*object.File, deleted []*object.File) (map[int]int, error) {
+  pairs := make(map[int]int)
+  if len(added) == 0 || len(deleted) == 0 || len(added) > RenameLimit || len(deleted) > RenameLimit {
+    return pairs, nil
+  }
+  type candidate struct {
+    to    int
+    from  int
+    score int
+  }
+  contents := make(map[*object.File][]byte)
+  readContents := func(file *object.File) ([]byte, error) {
+    if data, ok := contents[file]; ok {
+      return data, nil
+    }
+    data, err := GetFileContent(file)
+    if err != nil {
+      return nil, err
+    }
+    contents[file] = data
+    return data, nil
+  }
+  var candidates []candidate
+  for to, toFile := range added {
+    for from, fromFile := range deleted {
+      // Files can't be similar enough if one of them is much larger,
+      // which saves reading them.
+      smaller, larger := toFile.Size, fromFile.Size
+      if smaller > larger {
+        smaller, larger = larger, smaller
+      }
+      if larger == 0 || smaller*100/larger < RenameSimilarity {
+        continue
+      }
+      toContents, err := readContents(toFile)
+      if err != nil {
+        return nil, err
+      }
+      fromContents, err := readContents(fromFile)
+      if err != nil {
+        return nil, err
+      }
+      if toContents == nil || fromContents == nil {
+        continue
+      }
+      if score := ContentSimilarity(fromContents, toContents); score >= RenameSimilarity {
+        candidates = append(candidates, candidate{to: to, from: from, score: score})
+      }
+    }
+  }
+  sort.SliceStable(candidates, func(i, j int) bool {
+    return candidates[i].score > candidates[j].score
+  })
+  used := make(map[int]bool)
+  for _, c := range candidates {
+    if _, ok := pairs[c.to]; ok || used[c.from] {
+      continue
+    }
+    pairs[c.to] = c.from
+    used[c.from] = true
+  }
+  return pairs, nil
+}
+
+// ContentSimilarity returns the percentage of the larger of two contents
+// that is made up of lines they have in common.
+func ContentSimilarity(a []byte, b []byte) int {
+  larger := len(a)
+  if len(b) > larger {
+    larger = len(b)
+  }
+  if larger == 0 {
+    return 0
+  }
+  lines := make(map[string]int)
+  for _, line := range strings.SplitAfter(string(a), "\n") {
+    lines[line]++
+  }
+  common := 0
+  for _, line := range strings.SplitAfter(string(b), "\n") {
+    if lines[line] > 0 {
+      lines[line]--
+      common += len(line)
+    }
+  }
+  return common * 100 / larger
+}
+
+// isChangeInTree reports whether the result of a change is already in tree,
+// meaning the changed file has the same contents or is deleted there too.
+func isChangeInTree(change *object.Change, tree *object.Tree) bool {
+  if change.To.Name == "" {
+    _, err := tree.FindEntry(change.From.Name)
+    return err != nil
+  }
+  entry, err := tree.FindEntry(change.To.Name)
+  return err == nil && entry.Hash == change.To.TreeEntry.Hash && entry.Mode == change.To.TreeEntry.Mode
 }
 
 func GetChangeAction(change *object.Change) string {
@@ -118,3 +624,247 @@ func GetChangePath(change *object.Change) string {
     return change.To.Name
   }
 }
+
+func GetChangeContent(change *object.Change) ([]byte, error) {
+  _, to, err := change.Files()
+  if err != nil {
+    return nil, err
+  }
+  return GetFileContent(to)
+}
+
+// GetFileContent returns the contents of a file, or nil if the file is
+// missing, binary or larger than MaximumFileSize.
+func GetFileContent(file *object.File) ([]byte, error) {
+  if file == nil || file.Size > MaximumFileSize {
+    return nil, nil
+  }
+  isBinary, err := file.IsBinary()
+  if err != nil {
+    return nil, err
+  }
+  if isBinary {
+    return nil, nil
+  }
+  contents, err := file.Contents()
+  if err != nil {
+    return nil, err
+  }
+  return []byte(contents), nil
+}
+
+func GetChangeAdditions(change *object.Change) ([]MatchLine, error) {
+  var additions []MatchLine
+  patch, err := change.Patch()
+  if err != nil {
+    return nil, err
+  }
+  for _, filePatch := range patch.FilePatches() {
+    if filePatch.IsBinary() {
+      continue
+    }
+    lineNumber := 1
+    for _, chunk := range filePatch.Chunks() {
+      lines := strings.Split(strings.TrimSuffix(chunk.Content(), "\n"), "\n")
+      switch chunk.Type() {
+      case diff.Equal:
+        lineNumber += len(lines)
+      case diff.Add:
+        for _, line := range lines {
+          additions = append(additions, MatchLine{LineNumber: lineNumber, Content: line})
+          lineNumber++
+        }
+      }
+    }
+  }
+  return additions, nil
+}
+
+// GetFileAdditions returns the lines added to a file that changed from
+// one version to another. All lines are added if from is nil.
+func GetFileAdditions(from *object.File, to *object.File) ([]MatchLine, error) {
+  var additions []MatchLine
+  var fromContents string
+  if from != nil {
+    contents, err := GetFileContent(from)
+    if err != nil {
+      return nil, err
+    }
+    fromContents = string(contents)
+  }
+  toContents, err := GetFileContent(to)
+  if err != nil || toContents == nil {
+    return nil, err
+  }
+  lineNumber := 1
+  for _, d := range utildiff.Do(fromContents, string(toContents)) {
+    lines := strings.Split(strings.TrimSuffix(d.Text, "\n"), "\n")
+    switch d.Type {
+    case diffmatchpatch.DiffEqual:
+      lineNumber += len(lines)
+    case diffmatchpatch.DiffInsert:
+      for _, line := range lines {
+        additions = append(additions, MatchLine{LineNumber: lineNumber, Content: line})
+        lineNumber++
+      }
+    }
+  }
+  return additions, nil
+}
+
+func GetFileContents(repository *git.Repository, commitHash string, path string) (*object.File, error) {
+  commit, err := repository.CommitObject(plumbing.NewHash(commitHash))
+  if err != nil {
+    return nil, err
+  }
+  return commit.File(path)
+}
+
+// commitQueue is a heap of commits with the most recently committed first.
+type commitQueue []*object.Commit
+
+func (q commitQueue) Len() int {
+  return len(q)
+}
+
+func (q commitQueue) Less(i, j int) bool {
+  return q[i].Committer.When.After(q[j].Committer.When)
+}
+
+func (q commitQueue) Swap(i, j int) {
+  q[i], q[j] = q[j], q[i]
+}
+
+func (q *commitQueue) Push(x interface{}) {
+  *q = append(*q, x.(*object.Commit))
+}
+
+func (q *commitQueue) Pop() interface{} {
+  old := *q
+  commit := old[len(old)-1]
+  *q = old[:len(old)-1]
+  return commit
+}
+
+// commitWalk walks two sides of a history at once, newest first like git
+// rev-list, such as the commits of a push and those already known to the
+// remote. Everything reachable from a known commit is marked as known, and
+// the walk stops as soon as only known commits are left, so only the history
+// since the two sides diverged is read.
+type commitWalk struct {
+  repository *git.Repository
+  commits    map[plumbing.Hash]*object.Commit
+  known      map[plumbing.Hash]bool
+  queued     map[plumbing.Hash]bool
+  queue      commitQueue
+}
+
+func newCommitWalk(repository *git.Repository) *commitWalk {
+  return &commitWalk{
+    repository: repository,
+    commits:    make(map[plumbing.Hash]*object.Commit),
+    known:      make(map[plumbing.Hash]bool),
+    queued:     make(map[plumbing.Hash]bool),
+  }
+}
+
+// add queues a commit to be walked. Commits that were already walked are
+// only queued again when they turn out to be known, so that their parents
+// are marked as known too. Commits that don't exist in the repository, such
+// as the remote side of a force push, are ignored.
+func (w *commitWalk) add(hash plumbing.Hash, known bool) error {
+  if known {
+    if w.known[hash] {
+      return nil
+    }
+    w.known[hash] = true
+  }
+  commit, seen := w.commits[hash]
+  if seen && (!known || w.queued[hash]) {
+    return nil
+  }
+  if !seen {
+    var err error
+    commit, err = w.repository.CommitObject(hash)
+    if err == plumbing.ErrObjectNotFound {
+      return nil
+    }
+    if err != nil {
+      return err
+    }
+    w.commits[hash] = commit
+  }
+  w.queued[hash] = true
+  heap.Push(&w.queue, commit)
+  return nil
+}
+
+// run walks the queued commits until only known ones are left and returns
+// the commits that aren't known, newest first.
+func (w *commitWalk) run() ([]*object.Commit, error) {
+  var walked []*object.Commit
+  for w.hasUnknown() {
+    commit := heap.Pop(&w.queue).(*object.Commit)
+    delete(w.queued, commit.Hash)
+    known := w.known[commit.Hash]
+    if !known {
+      walked = append(walked, commit)
+    }
+    for _, parent := range commit.ParentHashes {
+      if err := w.add(parent, known); err != nil {
+        return nil, err
+      }
+    }
+  }
+  // Commits with skewed dates can be walked before a known descendant.
+  var commits []*object.Commit
+  for _, commit := range walked {
+    if !w.known[commit.Hash] {
+      commits = append(commits, commit)
+    }
+  }
+  return commits, nil
+}
+
+// hasUnknown reports whether any queued commit isn't known.
+func (w *commitWalk) hasUnknown() bool {
+  for _, commit := range w.queue {
+    if !w.known[commit.Hash] {
+      return true
+    }
+  }
+  return false
+}
+
+// walkCommits calls fn for every commit reachable from hash that isn't in
+// seen, first parents first like git log, and adds them to it. The walk ends
+// early if fn returns storer.ErrStop. Commits that don't exist in the
+// repository, such as the parents of a shallow clone's oldest commits, are
+// ignored.
+func walkCommits(repository *git.Repository, hash plumbing.Hash, seen map[plumbing.Hash]bool, fn func(*object.Commit) error) error {
+  stack := []plumbing.Hash{hash}
+  for len(stack) > 0 {
+    hash := stack[len(stack)-1]
+    stack = stack[:len(stack)-1]
+    if seen[hash] {
+      continue
+    }
+    seen[hash] = true
+    commit, err := repository.CommitObject(hash)
+    if err == plumbing.ErrObjectNotFound {
+      continue
+    }
+    if err != nil {
+      return err
+    }
+    if err := fn(commit); err == storer.ErrStop {
+      return nil
+    } else if err != nil {
+      return err
+    }
+    for i := len(commit.ParentHashes) - 1; i >= 0; i-- {
+      stack = append(stack, commit.ParentHashes[i])
+    }
+  }
+  return nil
+}
@@ -2,43 +2,213 @@ package core
 
 import (
   "context"
+  "errors"
+  "fmt"
+  "hash/fnv"
+  "net/http"
+  "net/url"
+  "strings"
+  "time"
 
   "github.com/google/go-github/github"
+  "gopkg.in/src-d/go-git.v4/plumbing/transport"
+  githttp "gopkg.in/src-d/go-git.v4/plumbing/transport/http"
 )
 
-type GithubOwner struct {
-  Login     *string
-  ID        *int64
-  Type      *string
-  Name      *string
-  AvatarURL *string
-  URL       *string
-  Company   *string
-  Blog      *string
-  Location  *string
-  Email     *string
-  Bio       *string
-}
-
-type GithubRepository struct {
-  Owner         *string
-  ID            *int64
-  Name          *string
-  FullName      *string
-  CloneURL      *string
-  URL           *string
-  DefaultBranch *string
-  Description   *string
-  Homepage      *string
-}
-
-func GetUserOrOrganization(login string, client *github.Client) (*GithubOwner, error) {
+const (
+  DefaultGithubURL       = "https://github.com"
+  DefaultAbuseRetryAfter = 60 * time.Second
+  GraphqlBatchSize       = 50
+)
+
+type GithubProvider struct {
+  client       *github.Client
+  webURL       string
+  accessToken  string
+  includeForks bool
+  out          *Logger
+  stats        *Stats
+}
+
+func NewGithubProvider(client *github.Client, webURL string, accessToken string, includeForks bool, out *Logger, stats *Stats) *GithubProvider {
+  return &GithubProvider{
+    client:       client,
+    webURL:       strings.TrimSuffix(webURL, "/"),
+    accessToken:  accessToken,
+    includeForks: includeForks,
+    out:          out,
+    stats:        stats,
+  }
+}
+
+// IsEnterprise reports whether the provider talks to a GitHub Enterprise
+// Server instance rather than github.com.
+func (p *GithubProvider) IsEnterprise() bool {
+  return p.webURL != DefaultGithubURL
+}
+
+func (p *GithubProvider) Name() string {
+  return ProviderGithub
+}
+
+func (p *GithubProvider) GetOwner(login string) (*Owner, error) {
+  return GetUserOrOrganization(login, p.client, p.wait)
+}
+
+func (p *GithubProvider) GetMembers(owner *Owner) ([]*Owner, error) {
+  return GetOrganizationMembers(owner.Login, p.client, p.wait)
+}
+
+func (p *GithubProvider) GetRepositories(owner *Owner) ([]*Repository, error) {
+  repos, err := GetRepositoriesFromOwner(owner.Login, p.client, p.includeForks, p.wait)
+  for _, repo := range repos {
+    url := p.RepositoryURL(*repo.Owner, *repo.Name)
+    repo.URL = &url
+  }
+  return repos, err
+}
+
+// GetGists returns the public gists of a user. Organizations can't own gists.
+func (p *GithubProvider) GetGists(owner *Owner) ([]*Repository, error) {
+  if *owner.Type != OwnerTypeUser {
+    return nil, nil
+  }
+  return GetGistsFromOwner(owner.Login, p.client, p.wait)
+}
+
+// GetWiki returns the wiki of a repository as a separate repository, or nil
+// if it has wikis disabled. Wikis that are enabled but have no pages don't
+// exist yet, which is only found out when cloning them.
+func (p *GithubProvider) GetWiki(repository *Repository) *Repository {
+  if !repository.HasWiki || repository.Gist || repository.Wiki {
+    return nil
+  }
+  name := fmt.Sprintf("%s.wiki", *repository.Name)
+  fullName := fmt.Sprintf("%s/%s", *repository.Owner, name)
+  cloneURL := fmt.Sprintf("%s.wiki.git", strings.TrimSuffix(*repository.CloneURL, ".git"))
+  url := fmt.Sprintf("%s/wiki", p.RepositoryURL(*repository.Owner, *repository.Name))
+  h := fnv.New64a()
+  h.Write([]byte(fmt.Sprintf("wiki/%d", *repository.ID)))
+  id := int64(h.Sum64() >> 1)
+  defaultBranch := ""
+  return &Repository{
+    Owner:         repository.Owner,
+    ID:            &id,
+    Name:          &name,
+    FullName:      &fullName,
+    CloneURL:      &cloneURL,
+    URL:           &url,
+    DefaultBranch: &defaultBranch,
+    Wiki:          true,
+  }
+}
+
+// FileRequest returns a request for the raw contents of a file. Files on
+// github.com are fetched from raw.githubusercontent.com, while Enterprise
+// instances are asked through the authenticated contents API since their raw
+// endpoints don't accept access tokens. Gists and wikis have no contents API,
+// so their files are always fetched from the raw endpoints.
+func (p *GithubProvider) FileRequest(repository *Repository, commit string, path string) (*http.Request, error) {
+  if repository.Wiki {
+    if p.IsEnterprise() {
+      return nil, errors.New("contents of wiki pages can't be fetched from GitHub Enterprise")
+    }
+    fileUrl := fmt.Sprintf("%s/wiki/%s/%s/%s/%s", GithubBaseUri, *repository.Owner, strings.TrimSuffix(*repository.Name, ".wiki"), commit, path)
+    return http.NewRequest("GET", fileUrl, nil)
+  }
+  if repository.Gist {
+    gistBaseUrl := GistBaseUri
+    if p.IsEnterprise() {
+      gistBaseUrl = fmt.Sprintf("%s/gist", p.webURL)
+    }
+    fileUrl := fmt.Sprintf("%s/%s/%s/raw/%s/%s", gistBaseUrl, *repository.Owner, *repository.Name, commit, path)
+    return http.NewRequest("GET", fileUrl, nil)
+  }
+  if !p.IsEnterprise() {
+    fileUrl := fmt.Sprintf("%s/%s/%s/%s/%s", GithubBaseUri, *repository.Owner, *repository.Name, commit, path)
+    return http.NewRequest("GET", fileUrl, nil)
+  }
+  fileUrl := fmt.Sprintf("%srepos/%s/%s/contents/%s?ref=%s", p.client.BaseURL, url.PathEscape(*repository.Owner), url.PathEscape(*repository.Name), escapePathSegments(path), url.QueryEscape(commit))
+  req, err := http.NewRequest("GET", fileUrl, nil)
+  if err != nil {
+    return nil, err
+  }
+  req.Header.Set("Accept", "application/vnd.github.v3.raw")
+  req.Header.Set("Authorization", fmt.Sprintf("token %s", p.accessToken))
+  return req, nil
+}
+
+// RepositoryURL returns the web URL of a repository on the configured
+// GitHub instance.
+func (p *GithubProvider) RepositoryURL(owner string, name string) string {
+  return fmt.Sprintf("%s/%s/%s", p.webURL, owner, name)
+}
+
+// CloneAuth authenticates clones with the access token, which is needed for
+// private and internal repositories and on instances in private mode.
+func (p *GithubProvider) CloneAuth() transport.AuthMethod {
+  if p.accessToken == "" {
+    return nil
+  }
+  return &githttp.BasicAuth{Username: "x-access-token", Password: p.accessToken}
+}
+
+// escapePathSegments escapes every segment of a slash separated path.
+func escapePathSegments(path string) string {
+  segments := strings.Split(path, "/")
+  for i, segment := range segments {
+    segments[i] = url.PathEscape(segment)
+  }
+  return strings.Join(segments, "/")
+}
+
+// RateLimitWaiter is called with the response and error of every GitHub API
+// request and returns true if the request should be retried.
+type RateLimitWaiter func(resp *github.Response, err error) bool
+
+// wait records the remaining API quota and sleeps until the rate limit resets
+// if a request failed because it was exceeded.
+func (p *GithubProvider) wait(resp *github.Response, err error) bool {
+  if resp != nil && resp.Rate.Limit > 0 {
+    p.stats.UpdateRateLimit(resp.Rate.Limit, resp.Rate.Remaining, resp.Rate.Reset.Time)
+  }
+  var duration time.Duration
+  switch err := err.(type) {
+  case *github.RateLimitError:
+    p.stats.UpdateRateLimit(err.Rate.Limit, err.Rate.Remaining, err.Rate.Reset.Time)
+    duration = time.Until(err.Rate.Reset.Time) + time.Second
+    p.out.Warn(" GitHub API rate limit exceeded, waiting until %s...\n", err.Rate.Reset.Time.Format(time.RFC3339))
+  case *github.AbuseRateLimitError:
+    duration = DefaultAbuseRetryAfter
+    if err.RetryAfter != nil {
+      duration = *err.RetryAfter
+    }
+    p.out.Warn(" GitHub API abuse detection triggered, waiting %d seconds...\n", int(duration.Seconds()))
+  default:
+    return false
+  }
+  if duration < time.Second {
+    duration = time.Second
+  }
+  time.Sleep(duration)
+  return true
+}
+
+func GetUserOrOrganization(login string, client *github.Client, wait RateLimitWaiter) (*Owner, error) {
   ctx := context.Background()
-  user, _, err := client.Users.Get(ctx, login)
+  var user *github.User
+  var resp *github.Response
+  var err error
+  for {
+    user, resp, err = client.Users.Get(ctx, login)
+    if !wait(resp, err) {
+      break
+    }
+  }
   if err != nil {
     return nil, err
   }
-  return &GithubOwner{
+  return &Owner{
     Login:     user.Login,
     ID:        user.ID,
     Type:      user.Type,
@@ -53,34 +223,53 @@ func GetUserOrOrganization(login string, client *github.Client) (*GithubOwner, e
   }, nil
 }
 
-func GetRepositoriesFromOwner(login *string, client *github.Client) ([]*GithubRepository, error) {
-  var allRepos []*GithubRepository
+// GetRepositoriesFromOwner returns the repositories of a user or
+// organization. Forks are left out unless includeForks is set, in which case
+// the repository they were forked from is looked up as their parent.
+func GetRepositoriesFromOwner(login *string, client *github.Client, includeForks bool, wait RateLimitWaiter) ([]*Repository, error) {
+  var allRepos []*Repository
+  var forks []*Repository
   loginVal := *login
   ctx := context.Background()
   opt := &github.RepositoryListOptions{
     Type: "sources",
   }
+  if includeForks {
+    opt.Type = "owner"
+  }
 
   for {
     repos, resp, err := client.Repositories.List(ctx, loginVal, opt)
+    if wait(resp, err) {
+      continue
+    }
     if err != nil {
       return allRepos, err
     }
     for _, repo := range repos {
-      if !*repo.Fork {
-        r := GithubRepository{
-          Owner:         repo.Owner.Login,
-          ID:            repo.ID,
-          Name:          repo.Name,
-          FullName:      repo.FullName,
-          CloneURL:      repo.CloneURL,
-          URL:           repo.HTMLURL,
-          DefaultBranch: repo.DefaultBranch,
-          Description:   repo.Description,
-          Homepage:      repo.Homepage,
-        }
-        allRepos = append(allRepos, &r)
+      if repo.GetFork() && !includeForks {
+        continue
+      }
+      r := Repository{
+        Owner:         repo.Owner.Login,
+        ID:            repo.ID,
+        Name:          repo.Name,
+        FullName:      repo.FullName,
+        CloneURL:      repo.CloneURL,
+        URL:           repo.HTMLURL,
+        DefaultBranch: repo.DefaultBranch,
+        Description:   repo.Description,
+        Homepage:      repo.Homepage,
+        Fork:          repo.GetFork(),
+        HasWiki:       repo.GetHasWiki(),
+      }
+      if repo.Parent != nil {
+        r.ParentFullName = repo.Parent.FullName
+        r.ParentCloneURL = repo.Parent.CloneURL
+      } else if r.Fork {
+        forks = append(forks, &r)
       }
+      allRepos = append(allRepos, &r)
     }
     if resp.NextPage == 0 {
       break
@@ -88,21 +277,156 @@ func GetRepositoriesFromOwner(login *string, client *github.Client) ([]*GithubRe
     opt.Page = resp.NextPage
   }
 
+  if err := setRepositoryParents(ctx, client, forks, wait); err != nil {
+    return allRepos, err
+  }
   return allRepos, nil
 }
 
-func GetOrganizationMembers(login *string, client *github.Client) ([]*GithubOwner, error) {
-  var allMembers []*GithubOwner
+// GetGistsFromOwner returns the public gists of a user as repositories named
+// after the gist ID.
+func GetGistsFromOwner(login *string, client *github.Client, wait RateLimitWaiter) ([]*Repository, error) {
+  var allGists []*Repository
+  loginVal := *login
+  ctx := context.Background()
+  opt := &github.GistListOptions{}
+  for {
+    gists, resp, err := client.Gists.List(ctx, loginVal, opt)
+    if wait(resp, err) {
+      continue
+    }
+    if err != nil {
+      return allGists, err
+    }
+    for _, gist := range gists {
+      allGists = append(allGists, newGistRepository(loginVal, gist))
+    }
+    if resp.NextPage == 0 {
+      break
+    }
+    opt.Page = resp.NextPage
+  }
+  return allGists, nil
+}
+
+// newGistRepository returns a repository for a gist. Gist IDs aren't
+// numeric, so the repository ID is a hash of it.
+func newGistRepository(login string, gist *github.Gist) *Repository {
+  owner := login
+  if gist.Owner != nil && gist.Owner.Login != nil {
+    owner = *gist.Owner.Login
+  }
+  fullName := fmt.Sprintf("%s/%s", owner, *gist.ID)
+  h := fnv.New64a()
+  h.Write([]byte("gist/" + *gist.ID))
+  id := int64(h.Sum64() >> 1)
+  defaultBranch := ""
+  return &Repository{
+    Owner:         &owner,
+    ID:            &id,
+    Name:          gist.ID,
+    FullName:      &fullName,
+    CloneURL:      gist.GitPullURL,
+    URL:           gist.HTMLURL,
+    DefaultBranch: &defaultBranch,
+    Description:   gist.Description,
+    Gist:          true,
+  }
+}
+
+type graphqlRequest struct {
+  Query     string                 `json:"query"`
+  Variables map[string]interface{} `json:"variables"`
+}
+
+type graphqlRepositoryParent struct {
+  Parent *struct {
+    NameWithOwner string `json:"nameWithOwner"`
+    URL           string `json:"url"`
+  } `json:"parent"`
+}
+
+type graphqlParentsResponse struct {
+  Data   map[string]*graphqlRepositoryParent `json:"data"`
+  Errors []struct {
+    Message string `json:"message"`
+  } `json:"errors"`
+}
+
+// setRepositoryParents sets the repository that each fork was created from.
+// The REST API only includes the parent when a single repository is
+// requested, so the parents are looked up with GraphQL queries for
+// GraphqlBatchSize forks at a time instead.
+func setRepositoryParents(ctx context.Context, client *github.Client, forks []*Repository, wait RateLimitWaiter) error {
+  for start := 0; start < len(forks); start += GraphqlBatchSize {
+    end := start + GraphqlBatchSize
+    if end > len(forks) {
+      end = len(forks)
+    }
+    batch := forks[start:end]
+    var params, fields []string
+    variables := make(map[string]interface{})
+    for i, fork := range batch {
+      params = append(params, fmt.Sprintf("$owner%d: String!, $name%d: String!", i, i))
+      fields = append(fields, fmt.Sprintf("repository%d: repository(owner: $owner%d, name: $name%d) { parent { nameWithOwner url } }", i, i, i))
+      variables[fmt.Sprintf("owner%d", i)] = *fork.Owner
+      variables[fmt.Sprintf("name%d", i)] = *fork.Name
+    }
+    query := &graphqlRequest{
+      Query:     fmt.Sprintf("query(%s) { %s }", strings.Join(params, ", "), strings.Join(fields, " ")),
+      Variables: variables,
+    }
+
+    var result graphqlParentsResponse
+    for {
+      // The GraphQL endpoint is /graphql on github.com and /api/graphql
+      // next to the /api/v3/ REST API on GitHub Enterprise.
+      req, err := client.NewRequest("POST", "../graphql", query)
+      if err != nil {
+        return err
+      }
+      resp, err := client.Do(ctx, req, &result)
+      if wait(resp, err) {
+        continue
+      }
+      if err != nil {
+        return err
+      }
+      break
+    }
+    if result.Data == nil && len(result.Errors) > 0 {
+      return errors.New(fmt.Sprintf("error looking up parents of forks: %s", result.Errors[0].Message))
+    }
+
+    for i, fork := range batch {
+      repository := result.Data[fmt.Sprintf("repository%d", i)]
+      if repository == nil || repository.Parent == nil {
+        continue
+      }
+      fullName := repository.Parent.NameWithOwner
+      cloneURL := repository.Parent.URL + ".git"
+      fork.ParentFullName = &fullName
+      fork.ParentCloneURL = &cloneURL
+    }
+  }
+  return nil
+}
+
+func GetOrganizationMembers(login *string, client *github.Client, wait RateLimitWaiter) ([]*Owner, error) {
+  var allMembers []*Owner
   loginVal := *login
   ctx := context.Background()
   opt := &github.ListMembersOptions{}
   for {
     members, resp, err := client.Organizations.ListMembers(ctx, loginVal, opt)
+    if wait(resp, err) {
+      continue
+    }
     if err != nil {
       return allMembers, err
     }
     for _, member := range members {
-      allMembers = append(allMembers, &GithubOwner{Login: member.Login, ID: member.ID, Type: member.Type})
+      allMembers = append(allMembers, &Owner{Login: member.Login, ID: member.ID, Type: member.Type})
     }
     if resp.NextPage == 0 {
       break
@@ -111,3 +435,19 @@ func GetOrganizationMembers(login *string, client *github.Client) ([]*GithubOwne
   }
   return allMembers, nil
 }
+
+// parseBaseURL parses an absolute API base URL and makes sure it ends in a
+// slash as required by the GitHub client.
+func parseBaseURL(rawURL string) (*url.URL, error) {
+  if !strings.HasSuffix(rawURL, "/") {
+    rawURL += "/"
+  }
+  baseURL, err := url.Parse(rawURL)
+  if err != nil {
+    return nil, err
+  }
+  if !baseURL.IsAbs() || baseURL.Host == "" {
+    return nil, errors.New(fmt.Sprintf("%s is not an absolute URL", rawURL))
+  }
+  return baseURL, nil
+}
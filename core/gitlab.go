@@ -0,0 +1,377 @@
+package core
+
+import (
+  "encoding/json"
+  "errors"
+  "fmt"
+  "net/http"
+  "net/url"
+  "strconv"
+  "strings"
+  "time"
+
+  "gopkg.in/src-d/go-git.v4/plumbing/transport"
+  githttp "gopkg.in/src-d/go-git.v4/plumbing/transport/http"
+)
+
+const (
+  DefaultGitlabURL        = "https://gitlab.com"
+  GitlabPerPage           = 100
+  DefaultGitlabRetryAfter = 60 * time.Second
+)
+
+type gitlabUser struct {
+  ID           int64  `json:"id"`
+  Username     string `json:"username"`
+  Name         string `json:"name"`
+  AvatarURL    string `json:"avatar_url"`
+  WebURL       string `json:"web_url"`
+  WebsiteURL   string `json:"website_url"`
+  Organization string `json:"organization"`
+  Location     string `json:"location"`
+  PublicEmail  string `json:"public_email"`
+  Bio          string `json:"bio"`
+}
+
+type gitlabGroup struct {
+  ID          int64  `json:"id"`
+  FullPath    string `json:"full_path"`
+  Name        string `json:"name"`
+  AvatarURL   string `json:"avatar_url"`
+  WebURL      string `json:"web_url"`
+  Description string `json:"description"`
+}
+
+type gitlabProject struct {
+  ID                int64  `json:"id"`
+  Path              string `json:"path"`
+  PathWithNamespace string `json:"path_with_namespace"`
+  HTTPURLToRepo     string `json:"http_url_to_repo"`
+  WebURL            string `json:"web_url"`
+  DefaultBranch     string `json:"default_branch"`
+  Description       string `json:"description"`
+  Namespace         struct {
+    FullPath string `json:"full_path"`
+  } `json:"namespace"`
+  ForkedFromProject *gitlabProject `json:"forked_from_project"`
+}
+
+type GitlabProvider struct {
+  baseURL      string
+  accessToken  string
+  includeForks bool
+  client       *http.Client
+  out          *Logger
+  stats        *Stats
+}
+
+func NewGitlabProvider(baseURL string, accessToken string, includeForks bool, out *Logger, stats *Stats) *GitlabProvider {
+  return &GitlabProvider{
+    baseURL:      strings.TrimSuffix(baseURL, "/"),
+    accessToken:  accessToken,
+    includeForks: includeForks,
+    client:       http.DefaultClient,
+    out:          out,
+    stats:        stats,
+  }
+}
+
+func (p *GitlabProvider) Name() string {
+  return ProviderGitlab
+}
+
+func (p *GitlabProvider) GetOwner(login string) (*Owner, error) {
+  var group gitlabGroup
+  found, err := p.get(fmt.Sprintf("/groups/%s", url.PathEscape(login)), nil, &group)
+  if err != nil {
+    return nil, err
+  }
+  if found {
+    ownerType := OwnerTypeGroup
+    return &Owner{
+      Login:     &group.FullPath,
+      ID:        &group.ID,
+      Type:      &ownerType,
+      Name:      &group.Name,
+      AvatarURL: &group.AvatarURL,
+      URL:       &group.WebURL,
+      Bio:       &group.Description,
+    }, nil
+  }
+
+  var users []gitlabUser
+  if _, err := p.get("/users", url.Values{"username": {login}}, &users); err != nil {
+    return nil, err
+  }
+  if len(users) == 0 {
+    return nil, errors.New(fmt.Sprintf("no GitLab user or group found with name %s", login))
+  }
+  return newGitlabOwner(users[0]), nil
+}
+
+// GetMembers returns the members of a group and of all its subgroups, once
+// each, including the members they inherit from their parent groups.
+func (p *GitlabProvider) GetMembers(owner *Owner) ([]*Owner, error) {
+  var allMembers []*Owner
+  seen := make(map[int64]bool)
+  err := p.getGroupMembers(*owner.ID, seen, &allMembers)
+  return allMembers, err
+}
+
+func (p *GitlabProvider) GetRepositories(owner *Owner) ([]*Repository, error) {
+  if *owner.Type == OwnerTypeGroup {
+    return p.getGroupProjects(*owner.ID)
+  }
+  return p.getProjects(fmt.Sprintf("/users/%d/projects", *owner.ID))
+}
+
+// GetGists returns nothing since GitLab has no gists.
+func (p *GitlabProvider) GetGists(owner *Owner) ([]*Repository, error) {
+  return nil, nil
+}
+
+// GetWiki returns nil since only GitHub wikis are supported.
+func (p *GitlabProvider) GetWiki(repository *Repository) *Repository {
+  return nil
+}
+
+func (p *GitlabProvider) FileRequest(repository *Repository, commit string, path string) (*http.Request, error) {
+  fileUrl := fmt.Sprintf("%s/api/v4/projects/%d/repository/files/%s/raw?ref=%s", p.baseURL, *repository.ID, url.PathEscape(path), url.QueryEscape(commit))
+  req, err := http.NewRequest("GET", fileUrl, nil)
+  if err != nil {
+    return nil, err
+  }
+  req.Header.Set("PRIVATE-TOKEN", p.accessToken)
+  return req, nil
+}
+
+func (p *GitlabProvider) CloneAuth() transport.AuthMethod {
+  return &githttp.BasicAuth{Username: "oauth2", Password: p.accessToken}
+}
+
+func (p *GitlabProvider) getGroupMembers(groupID int64, seen map[int64]bool, allMembers *[]*Owner) error {
+  err := p.getPages(fmt.Sprintf("/groups/%d/members/all", groupID), nil, func(data json.RawMessage) error {
+    var members []gitlabUser
+    if err := json.Unmarshal(data, &members); err != nil {
+      return err
+    }
+    for _, member := range members {
+      if seen[member.ID] {
+        continue
+      }
+      seen[member.ID] = true
+      *allMembers = append(*allMembers, newGitlabOwner(member))
+    }
+    return nil
+  })
+  if err != nil {
+    return err
+  }
+  subgroups, err := p.getSubgroups(groupID)
+  if err != nil {
+    return err
+  }
+  for _, subgroup := range subgroups {
+    if err := p.getGroupMembers(subgroup.ID, seen, allMembers); err != nil {
+      return err
+    }
+  }
+  return nil
+}
+
+func (p *GitlabProvider) getGroupProjects(groupID int64) ([]*Repository, error) {
+  allRepos, err := p.getProjects(fmt.Sprintf("/groups/%d/projects", groupID))
+  if err != nil {
+    return allRepos, err
+  }
+  subgroups, err := p.getSubgroups(groupID)
+  if err != nil {
+    return allRepos, err
+  }
+  for _, subgroup := range subgroups {
+    repos, err := p.getGroupProjects(subgroup.ID)
+    allRepos = append(allRepos, repos...)
+    if err != nil {
+      return allRepos, err
+    }
+  }
+  return allRepos, nil
+}
+
+func (p *GitlabProvider) getSubgroups(groupID int64) ([]gitlabGroup, error) {
+  var subgroups []gitlabGroup
+  err := p.getPages(fmt.Sprintf("/groups/%d/subgroups", groupID), nil, func(data json.RawMessage) error {
+    var page []gitlabGroup
+    if err := json.Unmarshal(data, &page); err != nil {
+      return err
+    }
+    subgroups = append(subgroups, page...)
+    return nil
+  })
+  return subgroups, err
+}
+
+func (p *GitlabProvider) getProjects(path string) ([]*Repository, error) {
+  var allRepos []*Repository
+  err := p.getPages(path, nil, func(data json.RawMessage) error {
+    var projects []gitlabProject
+    if err := json.Unmarshal(data, &projects); err != nil {
+      return err
+    }
+    for _, project := range projects {
+      if project.ForkedFromProject != nil && !p.includeForks {
+        continue
+      }
+      allRepos = append(allRepos, newGitlabRepository(project))
+    }
+    return nil
+  })
+  return allRepos, err
+}
+
+// get requests an API path and decodes the JSON response into v. It returns
+// false without an error if the resource does not exist.
+func (p *GitlabProvider) get(path string, query url.Values, v interface{}) (bool, error) {
+  resp, err := p.request(path, query)
+  if err != nil {
+    return false, err
+  }
+  defer resp.Body.Close()
+  if resp.StatusCode == http.StatusNotFound {
+    return false, nil
+  }
+  if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+    return false, err
+  }
+  return true, nil
+}
+
+func (p *GitlabProvider) getPages(path string, query url.Values, each func(data json.RawMessage) error) error {
+  if query == nil {
+    query = url.Values{}
+  }
+  query.Set("per_page", fmt.Sprintf("%d", GitlabPerPage))
+  page := "1"
+  for page != "" {
+    query.Set("page", page)
+    resp, err := p.request(path, query)
+    if err != nil {
+      return err
+    }
+    if resp.StatusCode == http.StatusNotFound {
+      resp.Body.Close()
+      return errors.New(fmt.Sprintf("no GitLab resource found at %s", path))
+    }
+    var data json.RawMessage
+    err = json.NewDecoder(resp.Body).Decode(&data)
+    resp.Body.Close()
+    if err != nil {
+      return err
+    }
+    if err := each(data); err != nil {
+      return err
+    }
+    page = resp.Header.Get("X-Next-Page")
+  }
+  return nil
+}
+
+func (p *GitlabProvider) request(path string, query url.Values) (*http.Response, error) {
+  requestUrl := fmt.Sprintf("%s/api/v4%s", p.baseURL, path)
+  if query != nil {
+    requestUrl = fmt.Sprintf("%s?%s", requestUrl, query.Encode())
+  }
+  req, err := http.NewRequest("GET", requestUrl, nil)
+  if err != nil {
+    return nil, err
+  }
+  req.Header.Set("PRIVATE-TOKEN", p.accessToken)
+  req.Header.Set("User-Agent", fmt.Sprintf("%s v%s", Name, Version))
+  for {
+    resp, err := p.client.Do(req)
+    if err != nil {
+      return nil, err
+    }
+    p.updateRateLimit(resp.Header)
+    if resp.StatusCode == http.StatusTooManyRequests {
+      resp.Body.Close()
+      duration := gitlabRetryAfter(resp.Header)
+      p.out.Warn(" GitLab API rate limit exceeded, waiting %d seconds...\n", int(duration.Seconds()))
+      time.Sleep(duration)
+      continue
+    }
+    if resp.StatusCode >= 400 && resp.StatusCode != http.StatusNotFound {
+      resp.Body.Close()
+      return nil, errors.New(fmt.Sprintf("GET %s: %s", requestUrl, resp.Status))
+    }
+    return resp, nil
+  }
+}
+
+// updateRateLimit records the remaining API quota from the rate limit
+// headers of a response, which GitLab only sends when rate limits are
+// enabled.
+func (p *GitlabProvider) updateRateLimit(header http.Header) {
+  limit, err := strconv.Atoi(header.Get("RateLimit-Limit"))
+  if err != nil {
+    return
+  }
+  remaining, _ := strconv.Atoi(header.Get("RateLimit-Remaining"))
+  reset, _ := strconv.ParseInt(header.Get("RateLimit-Reset"), 10, 64)
+  p.stats.UpdateRateLimit(limit, remaining, time.Unix(reset, 0))
+}
+
+// gitlabRetryAfter returns how long to wait before retrying a request that
+// was rate limited, from the Retry-After header in seconds or as a date, or
+// the RateLimit-Reset time.
+func gitlabRetryAfter(header http.Header) time.Duration {
+  duration := DefaultGitlabRetryAfter
+  retryAfter := header.Get("Retry-After")
+  if seconds, err := strconv.Atoi(retryAfter); err == nil {
+    duration = time.Duration(seconds) * time.Second
+  } else if date, err := http.ParseTime(retryAfter); err == nil {
+    duration = time.Until(date)
+  } else if reset, err := strconv.ParseInt(header.Get("RateLimit-Reset"), 10, 64); err == nil {
+    duration = time.Until(time.Unix(reset, 0)) + time.Second
+  }
+  if duration < time.Second {
+    duration = time.Second
+  }
+  return duration
+}
+
+func newGitlabOwner(user gitlabUser) *Owner {
+  ownerType := OwnerTypeUser
+  return &Owner{
+    Login:     &user.Username,
+    ID:        &user.ID,
+    Type:      &ownerType,
+    Name:      &user.Name,
+    AvatarURL: &user.AvatarURL,
+    URL:       &user.WebURL,
+    Company:   &user.Organization,
+    Blog:      &user.WebsiteURL,
+    Location:  &user.Location,
+    Email:     &user.PublicEmail,
+    Bio:       &user.Bio,
+  }
+}
+
+func newGitlabRepository(project gitlabProject) *Repository {
+  repo := &Repository{
+    Owner:         &project.Namespace.FullPath,
+    ID:            &project.ID,
+    Name:          &project.Path,
+    FullName:      &project.PathWithNamespace,
+    CloneURL:      &project.HTTPURLToRepo,
+    URL:           &project.WebURL,
+    DefaultBranch: &project.DefaultBranch,
+    Description:   &project.Description,
+  }
+  if parent := project.ForkedFromProject; parent != nil {
+    repo.Fork = true
+    repo.ParentFullName = &parent.PathWithNamespace
+    repo.ParentCloneURL = &parent.HTTPURLToRepo
+  }
+  return repo
+}
@@ -0,0 +1,241 @@
+package core
+
+import (
+  "bufio"
+  "errors"
+  "fmt"
+  "io"
+  "sort"
+  "strings"
+
+  "gopkg.in/src-d/go-git.v4"
+  "gopkg.in/src-d/go-git.v4/plumbing"
+  "gopkg.in/src-d/go-git.v4/plumbing/filemode"
+  "gopkg.in/src-d/go-git.v4/plumbing/format/index"
+  "gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+const (
+  HookPreCommit = "pre-commit"
+  HookPrePush   = "pre-push"
+)
+
+// StagedChange is a file in the index that differs from the HEAD commit.
+// From is nil for files that are new, and the file they were moved or
+// copied from for renames and copies.
+type StagedChange struct {
+  Action  string
+  OldPath string
+  From    *object.File
+  To      *object.File
+}
+
+func IsValidHook(hook string) bool {
+  return hook == HookPreCommit || hook == HookPrePush
+}
+
+// GetStagedChanges returns the added and modified files in the index of
+// repository. Deleted files are left out since they can't leak anything new,
+// but new files with the same contents as a deleted or modified file are
+// reported as renames or copies of it, and new files that are similar to a
+// deleted file as renames of it.
+func GetStagedChanges(repository *git.Repository) ([]StagedChange, error) {
+  idx, err := repository.Storer.Index()
+  if err != nil {
+    return nil, err
+  }
+  var headTree *object.Tree
+  head, err := repository.Head()
+  if err == nil {
+    commit, err := repository.CommitObject(head.Hash())
+    if err != nil {
+      return nil, err
+    }
+    if headTree, err = commit.Tree(); err != nil {
+      return nil, err
+    }
+  } else if err != plumbing.ErrReferenceNotFound {
+    return nil, err
+  }
+
+  staged := make(map[string]plumbing.Hash)
+  for _, entry := range idx.Entries {
+    staged[entry.Name] = entry.Hash
+  }
+  // Renames and copies can only be detected for paths that aren't in HEAD,
+  // so the files of HEAD are only read when the index has any.
+  headFiles := make(map[plumbing.Hash]*object.File)
+  if headTree != nil && hasNewPaths(headTree, idx.Entries) {
+    if err := collectChangedFiles(repository, headTree, staged, headFiles); err != nil {
+      return nil, err
+    }
+  }
+
+  var changes []StagedChange
+  var added []int
+  renamed := make(map[string]bool)
+  for _, entry := range idx.Entries {
+    if entry.Mode == filemode.Submodule {
+      continue
+    }
+    change := StagedChange{Action: "Insert"}
+    if headTree != nil {
+      if headEntry, err := headTree.FindEntry(entry.Name); err == nil {
+        if headEntry.Hash == entry.Hash {
+          continue
+        }
+        blob, err := repository.BlobObject(headEntry.Hash)
+        if err != nil {
+          return nil, err
+        }
+        change.Action = "Modify"
+        change.From = object.NewFile(entry.Name, headEntry.Mode, blob)
+      } else if from, ok := headFiles[entry.Hash]; ok && entry.Hash != emptyBlobHash {
+        change.Action = "Copy"
+        if _, ok := staged[from.Name]; !ok {
+          change.Action = "Rename"
+          renamed[from.Name] = true
+        }
+        change.OldPath = from.Name
+        change.From = from
+      }
+    }
+    blob, err := repository.BlobObject(entry.Hash)
+    if err != nil {
+      return nil, err
+    }
+    change.To = object.NewFile(entry.Name, entry.Mode, blob)
+    if change.From == nil {
+      added = append(added, len(changes))
+    }
+    changes = append(changes, change)
+  }
+
+  var deletedFiles []*object.File
+  for _, file := range headFiles {
+    if _, ok := staged[file.Name]; !ok && !renamed[file.Name] {
+      deletedFiles = append(deletedFiles, file)
+    }
+  }
+  sort.Slice(deletedFiles, func(i, j int) bool {
+    return deletedFiles[i].Name < deletedFiles[j].Name
+  })
+  addedFiles := make([]*object.File, len(added))
+  for i, index := range added {
+    addedFiles[i] = changes[index].To
+  }
+  pairs, err := pairSimilarFiles(addedFiles, deletedFiles)
+  if err != nil {
+    return nil, err
+  }
+  for to, from := range pairs {
+    change := &changes[added[to]]
+    change.Action = "Rename"
+    change.OldPath = deletedFiles[from].Name
+    change.From = deletedFiles[from]
+  }
+  return changes, nil
+}
+
+// hasNewPaths reports whether any file in the index isn't in tree.
+func hasNewPaths(tree *object.Tree, entries []*index.Entry) bool {
+  for _, entry := range entries {
+    if entry.Mode == filemode.Submodule {
+      continue
+    }
+    if _, err := tree.FindEntry(entry.Name); err != nil {
+      return true
+    }
+  }
+  return false
+}
+
+// collectChangedFiles adds the files of tree that are deleted or modified in
+// the index to files, keyed by their hash. Only the blobs of those files are
+// read.
+func collectChangedFiles(repository *git.Repository, tree *object.Tree, staged map[string]plumbing.Hash, files map[plumbing.Hash]*object.File) error {
+  walker := object.NewTreeWalker(tree, true, nil)
+  defer walker.Close()
+  for {
+    name, entry, err := walker.Next()
+    if err == io.EOF {
+      return nil
+    }
+    if err != nil {
+      return err
+    }
+    if !entry.Mode.IsFile() {
+      continue
+    }
+    if hash, ok := staged[name]; ok && hash == entry.Hash {
+      continue
+    }
+    blob, err := repository.BlobObject(entry.Hash)
+    if err != nil {
+      return err
+    }
+    files[entry.Hash] = object.NewFile(name, entry.Mode, blob)
+  }
+}
+
+// GetPushedCommits returns the commits that a push would send to the remote,
+// given the ref updates that git writes to the standard input of a pre-push
+// hook. Commits that are reachable from the remote side of an update or from
+// any remote-tracking branch are already known to the remote and left out.
+//
+// Like git rev-list, the pushed and the known commits are walked together,
+// newest first, and the walk stops as soon as everything left is known to
+// the remote. Only the history since the pushed refs diverged from the
+// remote is read, not the whole history behind every remote-tracking ref.
+func GetPushedCommits(repository *git.Repository, updates io.Reader) ([]*object.Commit, error) {
+  refs, err := repository.References()
+  if err != nil {
+    return nil, err
+  }
+  var remoteHashes []plumbing.Hash
+  err = refs.ForEach(func(ref *plumbing.Reference) error {
+    if ref.Name().IsRemote() && ref.Type() == plumbing.HashReference {
+      remoteHashes = append(remoteHashes, ref.Hash())
+    }
+    return nil
+  })
+  if err != nil {
+    return nil, err
+  }
+
+  var localHashes []plumbing.Hash
+  scanner := bufio.NewScanner(updates)
+  for scanner.Scan() {
+    fields := strings.Fields(scanner.Text())
+    if len(fields) == 0 {
+      continue
+    }
+    if len(fields) != 4 {
+      return nil, errors.New(fmt.Sprintf("unexpected ref update: %q", scanner.Text()))
+    }
+    localHash := plumbing.NewHash(fields[1])
+    remoteHash := plumbing.NewHash(fields[3])
+    if !localHash.IsZero() {
+      localHashes = append(localHashes, localHash)
+    }
+    if !remoteHash.IsZero() {
+      remoteHashes = append(remoteHashes, remoteHash)
+    }
+  }
+  if err := scanner.Err(); err != nil {
+    return nil, err
+  }
+
+  walk := newCommitWalk(repository)
+  for _, hash := range remoteHashes {
+    if err := walk.add(hash, true); err != nil {
+      return nil, err
+    }
+  }
+  for _, hash := range localHashes {
+    if err := walk.add(hash, false); err != nil {
+      return nil, err
+    }
+  }
+  return walk.run()
+}
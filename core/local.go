@@ -0,0 +1,85 @@
+package core
+
+import (
+  "fmt"
+  "hash/fnv"
+  "os"
+  "path/filepath"
+  "strings"
+
+  "gopkg.in/src-d/go-git.v4"
+)
+
+// GetLocalRepositories returns the git repository at location, or every
+// repository found in the directory tree below it. Bare repositories such
+// as mirrors are included. Directories that can't be read are logged to log
+// and skipped.
+func GetLocalRepositories(location string, log *Logger) ([]*Repository, error) {
+  var repositories []*Repository
+  root, err := filepath.Abs(location)
+  if err != nil {
+    return nil, err
+  }
+  err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+    if err != nil {
+      if path == root {
+        return err
+      }
+      log.Warn(" Skipping %s: %s\n", path, err)
+      return nil
+    }
+    if !info.IsDir() {
+      return nil
+    }
+    repository, err := git.PlainOpen(path)
+    if err == git.ErrRepositoryNotExists {
+      return nil
+    }
+    if err != nil {
+      log.Warn(" Skipping %s: %s\n", path, err)
+      return filepath.SkipDir
+    }
+    repositories = append(repositories, newLocalRepository(path, repository))
+    return filepath.SkipDir
+  })
+  if err != nil {
+    return nil, err
+  }
+  return repositories, nil
+}
+
+// newLocalRepository returns a repository for the one at path. The owner is
+// the name of the parent directory followed by a hash of its full path, so
+// repositories with the same name in directories with the same name, such
+// as ~/a/src/app and ~/b/src/app, are told apart.
+func newLocalRepository(path string, repository *git.Repository) *Repository {
+  parent, name := filepath.Split(path)
+  parent = filepath.Clean(parent)
+  base := filepath.Base(parent)
+  if base == string(filepath.Separator) {
+    base = "root"
+  }
+  owner := fmt.Sprintf("%s-%08x", base, hashString(parent)>>32)
+  name = strings.TrimSuffix(name, ".git")
+  fullName := owner + "/" + name
+  id := int64(hashString(path) >> 1)
+  defaultBranch := ""
+  if head, err := repository.Head(); err == nil {
+    defaultBranch = head.Name().Short()
+  }
+  return &Repository{
+    Owner:         &owner,
+    ID:            &id,
+    Name:          &name,
+    FullName:      &fullName,
+    CloneURL:      &path,
+    DefaultBranch: &defaultBranch,
+    Local:         true,
+  }
+}
+
+func hashString(s string) uint64 {
+  h := fnv.New64a()
+  h.Write([]byte(s))
+  return h.Sum64()
+}
@@ -5,31 +5,95 @@ import (
 )
 
 type Options struct {
-  CommitDepth       *int
-  GithubAccessToken *string `json:"-"`
-  NoExpandOrgs      *bool
-  Threads           *int
-  Save              *string `json:"-"`
-  Load              *string `json:"-"`
-  BindAddress       *string
-  Port              *int
-  Silent            *bool
-  Debug             *bool
-  Logins            []string
+  CommitDepth         *int
+  AllBranches         *bool
+  Tags                *bool
+  EntropyThreshold    *float64
+  Provider            *string
+  GithubAccessToken   *string `json:"-"`
+  GithubAPIURL        *string
+  GithubUploadURL     *string
+  GithubURL           *string
+  GitlabURL           *string
+  GitlabAccessToken   *string `json:"-"`
+  NoExpandOrgs        *bool
+  NoGists             *bool
+  IncludeForks        *bool
+  SignatureFiles      *string
+  NoDefaultSignatures *bool
+  Allowlist           *string
+  Local               *bool
+  Hook                *string
+  Wikis               *bool
+  Threads             *int
+  Save                *string `json:"-"`
+  Load                *string `json:"-"`
+  Resume              *string `json:"-"`
+  Diff                *string `json:"-"`
+  Export              *string `json:"-"`
+  ExportFormat        *string `json:"-"`
+  ExportTables        *bool   `json:"-"`
+  CheckpointInterval  *int
+  NoServer            *bool
+  CI                  *bool
+  FailSeverity        *string
+  FailExitCode        *int
+  BindAddress         *string
+  Port                *int
+  AuthToken           *bool
+  AuthFile            *string `json:"-"`
+  TLSCert             *string `json:"-"`
+  TLSKey              *string `json:"-"`
+  TLSSelfSigned       *bool
+  Silent              *bool
+  Debug               *bool
+  Logins              []string
 }
 
 func ParseOptions() (Options, error) {
   options := Options{
-    CommitDepth:       flag.Int("commit-depth", 500, "Number of repository commits to process"),
-    GithubAccessToken: flag.String("github-access-token", "", "GitHub access token to use for API requests"),
-    NoExpandOrgs:      flag.Bool("no-expand-orgs", false, "Don't add members to targets when processing organizations"),
-    Threads:           flag.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
-    Save:              flag.String("save", "", "Save session to file"),
-    Load:              flag.String("load", "", "Load session file"),
-    BindAddress:       flag.String("bind-address", "127.0.0.1", "Address to bind web server to"),
-    Port:              flag.Int("port", 9393, "Port to run web server on"),
-    Silent:            flag.Bool("silent", false, "Suppress all output except for errors"),
-    Debug:             flag.Bool("debug", false, "Print debugging information"),
+    CommitDepth:         flag.Int("commit-depth", 500, "Number of repository commits to process per branch or tag"),
+    AllBranches:         flag.Bool("all-branches", false, "Scan all branches instead of only the default branch"),
+    Tags:                flag.Bool("tags", false, "Also scan all tags"),
+    EntropyThreshold:    flag.Float64("entropy-threshold", DefaultEntropyThreshold, "Minimum Shannon entropy of added base64 strings to flag (hex strings are scaled accordingly)"),
+    Provider:            flag.String("provider", ProviderGithub, "Code hosting provider to gather targets from (github or gitlab)"),
+    GithubAccessToken:   flag.String("github-access-token", "", "GitHub access token to use for API requests"),
+    GithubAPIURL:        flag.String("github-api-url", "", "GitHub Enterprise API URL (e.g. https://github.example.com/api/v3/)"),
+    GithubUploadURL:     flag.String("github-upload-url", "", "GitHub Enterprise upload URL (default derived from -github-api-url)"),
+    GithubURL:           flag.String("github-url", "", "Base URL of GitHub web interface for links to files and commits (default derived from -github-api-url or https://github.com)"),
+    GitlabURL:           flag.String("gitlab-url", DefaultGitlabURL, "Base URL of GitLab instance"),
+    GitlabAccessToken:   flag.String("gitlab-access-token", "", "GitLab access token to use for API requests"),
+    IncludeForks:        flag.Bool("include-forks", false, "Also scan forked repositories, leaving out commits inherited from the repository they were forked from"),
+    NoExpandOrgs:        flag.Bool("no-expand-orgs", false, "Don't add members to targets when processing organizations or groups"),
+    NoGists:             flag.Bool("no-gists", false, "Don't scan the public gists of users"),
+    SignatureFiles:      flag.String("signatures", "", "Comma-separated list of YAML or JSON files with additional signatures"),
+    NoDefaultSignatures: flag.Bool("no-default-signatures", false, "Only use signatures loaded with -signatures"),
+    Allowlist:           flag.String("allowlist", "", "File with findings to suppress"),
+    Local:               flag.Bool("local", false, "Scan local repositories and directories given as targets instead of users and organizations"),
+    Hook:                flag.String("hook", "", "Run as git hook on the repository in the current directory: pre-commit or pre-push"),
+    Wikis:               flag.Bool("wikis", false, "Also scan the wikis of GitHub repositories"),
+    Threads:             flag.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
+    Save:                flag.String("save", "", "Save session to file"),
+    Load:                flag.String("load", "", "Load session file"),
+    Resume:              flag.String("resume", "", "Resume an unfinished scan from a session file"),
+    Diff:                flag.String("diff", "", "Compare findings, targets and repositories with a previous session file"),
+    Export:              flag.String("export", "", "Export findings to file"),
+    ExportFormat:        flag.String("export-format", "", "Format of -export file: sarif, csv or ndjson (default derived from file extension)"),
+    ExportTables:        flag.Bool("export-tables", false, "Also export targets and repositories to files next to the -export file (csv and ndjson only)"),
+    CheckpointInterval:  flag.Int("checkpoint-interval", 60, "Seconds between saving checkpoints of the session to the -save file during analysis (0 to disable)"),
+    NoServer:            flag.Bool("no-server", false, "Don't start the web server and exit when done"),
+    CI:                  flag.Bool("ci", false, "Run without web server and exit with -fail-exit-code if there are findings at or above -fail-severity"),
+    FailSeverity:        flag.String("fail-severity", SeverityLow, "Lowest severity of findings that fail a -ci run: critical, high, medium or low"),
+    FailExitCode:        flag.Int("fail-exit-code", 1, "Exit code of a -ci run with failing findings"),
+    BindAddress:         flag.String("bind-address", "127.0.0.1", "Address to bind web server to"),
+    Port:                flag.Int("port", 9393, "Port to run web server on"),
+    AuthToken:           flag.Bool("auth-token", false, "Require a bearer token generated at startup to access the web interface and API"),
+    TLSCert:             flag.String("tls-cert", "", "PEM encoded certificate file to serve the web interface over HTTPS with"),
+    TLSKey:              flag.String("tls-key", "", "PEM encoded private key file of the -tls-cert certificate"),
+    TLSSelfSigned:       flag.Bool("tls-self-signed", false, "Serve the web interface over HTTPS with a self-signed certificate generated at startup"),
+    AuthFile:            flag.String("auth-file", "", "htpasswd file with bcrypt hashed passwords of users allowed to access the web interface and API"),
+    Silent:              flag.Bool("silent", false, "Suppress all output except for errors"),
+    Debug:               flag.Bool("debug", false, "Print debugging information"),
   }
 
   flag.Parse()
@@ -0,0 +1,70 @@
+package core
+
+import (
+  "net/http"
+
+  "gopkg.in/src-d/go-git.v4/plumbing/transport"
+)
+
+const (
+  ProviderGithub = "github"
+  ProviderGitlab = "gitlab"
+
+  OwnerTypeUser         = "User"
+  OwnerTypeOrganization = "Organization"
+  OwnerTypeGroup        = "Group"
+)
+
+// Provider is a code hosting service that targets and their repositories
+// are gathered from.
+type Provider interface {
+  Name() string
+  GetOwner(login string) (*Owner, error)
+  GetMembers(owner *Owner) ([]*Owner, error)
+  GetRepositories(owner *Owner) ([]*Repository, error)
+  GetGists(owner *Owner) ([]*Repository, error)
+  GetWiki(repository *Repository) *Repository
+  FileRequest(repository *Repository, commit string, path string) (*http.Request, error)
+  CloneAuth() transport.AuthMethod
+}
+
+type Owner struct {
+  Login     *string
+  ID        *int64
+  Type      *string
+  Name      *string
+  AvatarURL *string
+  URL       *string
+  Company   *string
+  Blog      *string
+  Location  *string
+  Email     *string
+  Bio       *string
+}
+
+func (o *Owner) HasMembers() bool {
+  return *o.Type == OwnerTypeOrganization || *o.Type == OwnerTypeGroup
+}
+
+type Repository struct {
+  Owner          *string
+  ID             *int64
+  Name           *string
+  FullName       *string
+  CloneURL       *string
+  URL            *string
+  DefaultBranch  *string
+  Description    *string
+  Homepage       *string
+  Fork           bool
+  ParentFullName *string
+  ParentCloneURL *string
+  HasWiki        bool
+  Gist           bool
+  Wiki           bool
+  Local          bool
+  Analyzed       bool
+  Commits        int
+  Files          int
+  Suppressed     int
+}
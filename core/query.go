@@ -0,0 +1,256 @@
+package core
+
+import (
+  "errors"
+  "fmt"
+  "net/url"
+  "sort"
+  "strconv"
+  "strings"
+  "time"
+)
+
+const (
+  MaximumFindingsPageSize = 1000
+  queryDateLayout         = "2006-01-02"
+)
+
+// findingSortKeys compares two findings by the field a query is sorted on.
+var findingSortKeys = map[string]func(a *Finding, b *Finding) int{
+  "severity": func(a *Finding, b *Finding) int {
+    return SeverityRank(a.Severity) - SeverityRank(b.Severity)
+  },
+  "date": func(a *Finding, b *Finding) int {
+    switch {
+    case a.CommitDate.Before(b.CommitDate):
+      return -1
+    case a.CommitDate.After(b.CommitDate):
+      return 1
+    }
+    return 0
+  },
+  "path": func(a *Finding, b *Finding) int {
+    return strings.Compare(a.FilePath, b.FilePath)
+  },
+  "repository": func(a *Finding, b *Finding) int {
+    if c := strings.Compare(a.RepositoryOwner, b.RepositoryOwner); c != 0 {
+      return c
+    }
+    return strings.Compare(a.RepositoryName, b.RepositoryName)
+  },
+  "signature": func(a *Finding, b *Finding) int {
+    return strings.Compare(a.SignatureID, b.SignatureID)
+  },
+  "action": func(a *Finding, b *Finding) int {
+    return strings.Compare(a.Action, b.Action)
+  },
+}
+
+// FindingQuery filters, sorts and paginates the findings of a session. Empty
+// fields don't filter anything, and findings are in the order they were
+// found unless Sort is set.
+type FindingQuery struct {
+  Repository   string
+  Owner        string
+  Signature    string
+  Action       string
+  Author       string
+  Severity     string
+  TriageStatus string
+  Diff         string
+  Search       string
+  Since        time.Time
+  Until        time.Time
+  Sort         string
+  Descending   bool
+  Limit        int
+  Cursor       string
+}
+
+// FindingsPage is one page of the findings matching a query. Next is the
+// cursor of the following page, or empty if this is the last one.
+type FindingsPage struct {
+  Findings []*Finding
+  Total    int
+  Next     string
+}
+
+// ParseFindingQuery builds a query from the parameters of a request. Dates
+// are given as YYYY-MM-DD, which includes the whole day, or in RFC 3339
+// format.
+func ParseFindingQuery(values url.Values) (*FindingQuery, error) {
+  query := &FindingQuery{
+    Repository:   values.Get("repository"),
+    Owner:        values.Get("owner"),
+    Signature:    values.Get("signature"),
+    Action:       values.Get("action"),
+    Author:       strings.ToLower(values.Get("author")),
+    Severity:     values.Get("severity"),
+    TriageStatus: values.Get("status"),
+    Diff:         values.Get("diff"),
+    Search:       strings.ToLower(strings.TrimSpace(values.Get("q"))),
+    Cursor:       values.Get("cursor"),
+  }
+  if query.Severity != "" && SeverityRank(query.Severity) == 0 {
+    return nil, errors.New(fmt.Sprintf("Unknown severity: %s.", query.Severity))
+  }
+  if query.Diff != "" && query.Diff != "new" && query.Diff != "unchanged" {
+    return nil, errors.New(fmt.Sprintf("Unknown diff status: %s. Valid statuses are new and unchanged.", query.Diff))
+  }
+  var err error
+  if query.Since, err = parseQueryDate(values.Get("since"), false); err != nil {
+    return nil, err
+  }
+  if query.Until, err = parseQueryDate(values.Get("until"), true); err != nil {
+    return nil, err
+  }
+  if sortKey := values.Get("sort"); sortKey != "" {
+    query.Descending = strings.HasPrefix(sortKey, "-")
+    query.Sort = strings.TrimPrefix(sortKey, "-")
+    if _, ok := findingSortKeys[query.Sort]; !ok {
+      return nil, errors.New(fmt.Sprintf("Can't sort findings by %s.", query.Sort))
+    }
+  }
+  if limit := values.Get("limit"); limit != "" {
+    query.Limit, err = strconv.Atoi(limit)
+    if err != nil || query.Limit < 1 {
+      return nil, errors.New(fmt.Sprintf("Invalid limit: %s.", limit))
+    }
+    if query.Limit > MaximumFindingsPageSize {
+      query.Limit = MaximumFindingsPageSize
+    }
+  }
+  return query, nil
+}
+
+// parseQueryDate parses a date parameter. Dates without a time are the start
+// of the day, or the end of it if end is set.
+func parseQueryDate(value string, end bool) (time.Time, error) {
+  if value == "" {
+    return time.Time{}, nil
+  }
+  if date, err := time.Parse(queryDateLayout, value); err == nil {
+    if end {
+      date = date.Add(24*time.Hour - time.Nanosecond)
+    }
+    return date, nil
+  }
+  date, err := time.Parse(time.RFC3339, value)
+  if err != nil {
+    return time.Time{}, errors.New(fmt.Sprintf("Invalid date: %s. Use YYYY-MM-DD or RFC 3339 format.", value))
+  }
+  return date, nil
+}
+
+// Matches reports whether a finding matches the filters of the query.
+// Findings that aren't in the given diff statuses are left out when the
+// query filters on them.
+func (q *FindingQuery) Matches(f *Finding, diffStatuses map[string]string) bool {
+  if q.Repository != "" && q.Repository != f.RepositoryName && q.Repository != f.RepositoryOwner+"/"+f.RepositoryName {
+    return false
+  }
+  if q.Owner != "" && q.Owner != f.RepositoryOwner {
+    return false
+  }
+  if q.Signature != "" && q.Signature != f.SignatureID {
+    return false
+  }
+  if q.Action != "" && !strings.EqualFold(q.Action, f.Action) {
+    return false
+  }
+  if q.Author != "" && !strings.Contains(strings.ToLower(f.CommitAuthor), q.Author) {
+    return false
+  }
+  if q.Severity != "" && SeverityRank(f.Severity) < SeverityRank(q.Severity) {
+    return false
+  }
+  if q.TriageStatus != "" && q.TriageStatus != f.TriageStatus && !(q.TriageStatus == "untriaged" && f.TriageStatus == "") {
+    return false
+  }
+  if q.Diff != "" && diffStatuses[f.Id] != q.Diff {
+    return false
+  }
+  if !q.Since.IsZero() && f.CommitDate.Before(q.Since) {
+    return false
+  }
+  if !q.Until.IsZero() && f.CommitDate.After(q.Until) {
+    return false
+  }
+  if q.Search != "" {
+    haystack := strings.ToLower(strings.Join([]string{f.FilePath, f.OldPath, f.CommitHash, f.RepositoryOwner + "/" + f.RepositoryName, f.Category, f.SignatureID, f.Description, f.Assignee}, "\n"))
+    if !strings.Contains(haystack, q.Search) {
+      return false
+    }
+  }
+  return true
+}
+
+// QueryFindings returns a page of copies of the findings that match query.
+// The cursor of a page is the ID of its last finding, and the next page
+// starts right after wherever that finding is in the sort order, so pages
+// stay consistent while new findings are added during a scan.
+func (s *Session) QueryFindings(query *FindingQuery) (*FindingsPage, error) {
+  s.Lock()
+  defer s.Unlock()
+  if query.Diff != "" && s.Diff == nil {
+    return nil, errors.New("No previous session to compare with.")
+  }
+  diffStatuses := make(map[string]string)
+  if s.Diff != nil {
+    for _, f := range s.Diff.NewFindings {
+      diffStatuses[f.Id] = "new"
+    }
+    for _, f := range s.Diff.UnchangedFindings {
+      diffStatuses[f.Id] = "unchanged"
+    }
+  }
+
+  positions := make(map[*Finding]int, len(s.Findings))
+  for i, f := range s.Findings {
+    positions[f] = i
+  }
+  compare := findingSortKeys[query.Sort]
+  less := func(a *Finding, b *Finding) bool {
+    if compare != nil {
+      c := compare(a, b)
+      if query.Descending {
+        c = -c
+      }
+      if c != 0 {
+        return c < 0
+      }
+    }
+    return positions[a] < positions[b]
+  }
+
+  var cursor *Finding
+  if query.Cursor != "" {
+    if cursor = s.findFinding(query.Cursor); cursor == nil {
+      return nil, errors.New(fmt.Sprintf("Invalid cursor: %s.", query.Cursor))
+    }
+  }
+  page := &FindingsPage{}
+  var matches []*Finding
+  for _, f := range s.Findings {
+    if !query.Matches(f, diffStatuses) {
+      continue
+    }
+    page.Total++
+    if cursor == nil || less(cursor, f) {
+      matches = append(matches, f)
+    }
+  }
+  sort.Slice(matches, func(i, j int) bool {
+    return less(matches[i], matches[j])
+  })
+  if query.Limit > 0 && len(matches) > query.Limit {
+    matches = matches[:query.Limit]
+    page.Next = matches[len(matches)-1].Id
+  }
+  page.Findings = make([]*Finding, len(matches))
+  for i, f := range matches {
+    finding := *f
+    page.Findings[i] = &finding
+  }
+  return page, nil
+}
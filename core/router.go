@@ -1,9 +1,12 @@
 package core
 
 import (
+  "encoding/json"
   "fmt"
+  "io"
   "io/ioutil"
   "net/http"
+  "strconv"
   "strings"
 
   assetfs "github.com/elazarl/go-bindata-assetfs"
@@ -13,10 +16,13 @@ import (
 )
 
 const (
-  GithubBaseUri   = "https://raw.githubusercontent.com"
-  MaximumFileSize = 102400
-  CspPolicy       = "default-src 'none'; script-src 'self'; style-src 'self' 'unsafe-inline'; img-src 'self' data:; font-src 'self'"
-  ReferrerPolicy  = "no-referrer"
+  GithubBaseUri            = "https://raw.githubusercontent.com"
+  GistBaseUri              = "https://gist.githubusercontent.com"
+  MaximumFileSize          = 102400
+  MaximumTriageRequestSize = 65536
+  HstsMaxAge               = 31536000
+  CspPolicy                = "default-src 'none'; script-src 'self'; style-src 'self' 'unsafe-inline'; img-src 'self' data:; font-src 'self'"
+  ReferrerPolicy           = "no-referrer"
 )
 
 type binaryFileSystem struct {
@@ -52,7 +58,12 @@ func NewRouter(s *Session) *gin.Engine {
   }
 
   router := gin.New()
+  router.UseRawPath = true
   router.Use(static.Serve("/", BinaryFileSystem("static")))
+  var stsSeconds int64
+  if s.TLSCertificate != nil {
+    stsSeconds = HstsMaxAge
+  }
   router.Use(secure.New(secure.Config{
     SSLRedirect:           false,
     IsDevelopment:         false,
@@ -61,12 +72,89 @@ func NewRouter(s *Session) *gin.Engine {
     BrowserXssFilter:      true,
     ContentSecurityPolicy: CspPolicy,
     ReferrerPolicy:        ReferrerPolicy,
+    STSSeconds:            stsSeconds,
   }))
+  if s.IsAuthRequired() {
+    router.Use(AuthRequired(s.AuthToken, s.Credentials))
+  }
   router.GET("/stats", func(c *gin.Context) {
     c.JSON(200, s.Stats)
   })
   router.GET("/findings", func(c *gin.Context) {
-    c.JSON(200, s.Findings)
+    query, err := ParseFindingQuery(c.Request.URL.Query())
+    if err != nil {
+      c.JSON(http.StatusBadRequest, gin.H{
+        "message": err.Error(),
+      })
+      return
+    }
+    page, err := s.QueryFindings(query)
+    if err != nil {
+      c.JSON(http.StatusBadRequest, gin.H{
+        "message": err.Error(),
+      })
+      return
+    }
+    c.Header("X-Total-Count", strconv.Itoa(page.Total))
+    if page.Next != "" {
+      c.Header("X-Next-Cursor", page.Next)
+    }
+    c.JSON(200, page.Findings)
+  })
+  router.GET("/findings/:id", func(c *gin.Context) {
+    finding := s.FindFinding(c.Param("id"))
+    if finding == nil {
+      c.JSON(http.StatusNotFound, gin.H{
+        "message": "Finding not found",
+      })
+      return
+    }
+    c.JSON(200, finding)
+  })
+  router.PATCH("/findings/:id", func(c *gin.Context) {
+    var update TriageUpdate
+    body := http.MaxBytesReader(c.Writer, c.Request.Body, MaximumTriageRequestSize)
+    if err := json.NewDecoder(body).Decode(&update); err != nil {
+      c.JSON(http.StatusBadRequest, gin.H{
+        "message": "Invalid triage update",
+      })
+      return
+    }
+    finding, err := s.TriageFinding(c.Param("id"), update)
+    if err == ErrFindingNotFound {
+      c.JSON(http.StatusNotFound, gin.H{
+        "message": "Finding not found",
+      })
+      return
+    }
+    if err != nil {
+      c.JSON(http.StatusBadRequest, gin.H{
+        "message": err.Error(),
+      })
+      return
+    }
+    if location := s.TriageLocation(); location != "" {
+      if err := s.SaveToFile(location); err != nil {
+        s.Out.Error("Error saving triage of finding %s to %s: %s\n", finding.Id, location, err)
+        c.JSON(http.StatusInternalServerError, gin.H{
+          "message": "Triage could not be saved to the session file",
+        })
+        return
+      }
+    }
+    c.JSON(200, finding)
+  })
+  router.GET("/diff", func(c *gin.Context) {
+    if s.Diff == nil {
+      c.JSON(http.StatusNotFound, gin.H{
+        "message": "No previous session to compare with",
+      })
+      return
+    }
+    c.JSON(200, s.Diff)
+  })
+  router.GET("/errors", func(c *gin.Context) {
+    c.JSON(200, s.Errors)
   })
   router.GET("/targets", func(c *gin.Context) {
     c.JSON(200, s.Targets)
@@ -74,22 +162,48 @@ func NewRouter(s *Session) *gin.Engine {
   router.GET("/repositories", func(c *gin.Context) {
     c.JSON(200, s.Repositories)
   })
-  router.GET("/files/:owner/:repo/:commit/*path", fetchFile)
+  router.GET("/files/:owner/:repo/:commit/*path", func(c *gin.Context) {
+    repo := s.FindRepository(c.Param("owner"), c.Param("repo"))
+    if repo == nil {
+      c.JSON(http.StatusNotFound, gin.H{
+        "message": "No such repository",
+      })
+      return
+    }
+    if repo.Local {
+      fetchLocalFile(c, repo)
+      return
+    }
+    if s.Provider == nil {
+      c.JSON(http.StatusServiceUnavailable, gin.H{
+        "message": "No provider configured to fetch file from",
+      })
+      return
+    }
+    req, err := s.Provider.FileRequest(repo, c.Param("commit"), strings.TrimPrefix(c.Param("path"), "/"))
+    if err != nil {
+      c.JSON(http.StatusInternalServerError, gin.H{
+        "message": err,
+      })
+      return
+    }
+    fetchFile(c, req)
+  })
 
   return router
 }
 
-func fetchFile(c *gin.Context) {
-  fileUrl := fmt.Sprintf("%s/%s/%s/%s%s", GithubBaseUri, c.Param("owner"), c.Param("repo"), c.Param("commit"), c.Param("path"))
-  resp, err := http.Head(fileUrl)
+func fetchFile(c *gin.Context, req *http.Request) {
+  resp, err := http.DefaultClient.Do(req)
   if err != nil {
     c.JSON(http.StatusInternalServerError, gin.H{
       "message": err,
     })
     return
   }
+  defer resp.Body.Close()
 
-  if resp.StatusCode == http.StatusNotFound {
+  if resp.StatusCode != http.StatusOK {
     c.JSON(http.StatusNotFound, gin.H{
       "message": "No content",
     })
@@ -103,7 +217,7 @@ func fetchFile(c *gin.Context) {
     return
   }
 
-  resp, err = http.Get(fileUrl)
+  body, err := ioutil.ReadAll(io.LimitReader(resp.Body, MaximumFileSize+1))
   if err != nil {
     c.JSON(http.StatusInternalServerError, gin.H{
       "message": err,
@@ -111,8 +225,18 @@ func fetchFile(c *gin.Context) {
     return
   }
 
-  defer resp.Body.Close()
-  body, err := ioutil.ReadAll(resp.Body)
+  if len(body) > MaximumFileSize {
+    c.JSON(http.StatusUnprocessableEntity, gin.H{
+      "message": fmt.Sprintf("File size exceeds maximum of %d bytes", MaximumFileSize),
+    })
+    return
+  }
+
+  c.String(http.StatusOK, string(body[:]))
+}
+
+func fetchLocalFile(c *gin.Context, repo *Repository) {
+  repository, err := OpenRepository(repo.CloneURL)
   if err != nil {
     c.JSON(http.StatusInternalServerError, gin.H{
       "message": err,
@@ -120,5 +244,28 @@ func fetchFile(c *gin.Context) {
     return
   }
 
-  c.String(http.StatusOK, string(body[:]))
+  file, err := GetFileContents(repository, c.Param("commit"), strings.TrimPrefix(c.Param("path"), "/"))
+  if err != nil {
+    c.JSON(http.StatusNotFound, gin.H{
+      "message": "No content",
+    })
+    return
+  }
+
+  if file.Size > MaximumFileSize {
+    c.JSON(http.StatusUnprocessableEntity, gin.H{
+      "message": fmt.Sprintf("File size exceeds maximum of %d bytes", MaximumFileSize),
+    })
+    return
+  }
+
+  contents, err := file.Contents()
+  if err != nil {
+    c.JSON(http.StatusInternalServerError, gin.H{
+      "message": err,
+    })
+    return
+  }
+
+  c.String(http.StatusOK, contents)
 }
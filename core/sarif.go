@@ -0,0 +1,253 @@
+package core
+
+import (
+  "encoding/json"
+  "fmt"
+  "io"
+  "strings"
+)
+
+const (
+  SarifVersion = "2.1.0"
+  SarifSchema  = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+)
+
+var sarifLevels = map[string]string{
+  SeverityCritical: "error",
+  SeverityHigh:     "error",
+  SeverityMedium:   "warning",
+  SeverityLow:      "note",
+}
+
+// sarifSecuritySeverities are the scores code scanning dashboards use to
+// rank security results.
+var sarifSecuritySeverities = map[string]string{
+  SeverityCritical: "9.5",
+  SeverityHigh:     "8.0",
+  SeverityMedium:   "5.5",
+  SeverityLow:      "2.0",
+}
+
+type sarifLog struct {
+  Schema  string     `json:"$schema"`
+  Version string     `json:"version"`
+  Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+  Tool               sarifTool                        `json:"tool"`
+  OriginalUriBaseIds map[string]sarifArtifactLocation `json:"originalUriBaseIds,omitempty"`
+  Results            []sarifResult                    `json:"results"`
+}
+
+type sarifTool struct {
+  Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+  Name           string      `json:"name"`
+  Version        string      `json:"version"`
+  InformationUri string      `json:"informationUri"`
+  Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+  Id                   string                 `json:"id"`
+  ShortDescription     sarifMessage           `json:"shortDescription"`
+  Help                 *sarifMessage          `json:"help,omitempty"`
+  HelpUri              string                 `json:"helpUri,omitempty"`
+  DefaultConfiguration sarifConfiguration     `json:"defaultConfiguration"`
+  Properties           map[string]interface{} `json:"properties"`
+}
+
+type sarifConfiguration struct {
+  Level string `json:"level"`
+}
+
+type sarifMessage struct {
+  Text string `json:"text"`
+}
+
+type sarifResult struct {
+  RuleId              string                 `json:"ruleId"`
+  RuleIndex           int                    `json:"ruleIndex"`
+  Level               string                 `json:"level"`
+  Message             sarifMessage           `json:"message"`
+  Locations           []sarifLocation        `json:"locations"`
+  PartialFingerprints map[string]string      `json:"partialFingerprints"`
+  Properties          map[string]interface{} `json:"properties"`
+}
+
+type sarifLocation struct {
+  PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+  LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifPhysicalLocation struct {
+  ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+  Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+  Uri         string        `json:"uri,omitempty"`
+  UriBaseId   string        `json:"uriBaseId,omitempty"`
+  Description *sarifMessage `json:"description,omitempty"`
+}
+
+type sarifRegion struct {
+  StartLine int           `json:"startLine"`
+  Snippet   *sarifMessage `json:"snippet,omitempty"`
+}
+
+type sarifLogicalLocation struct {
+  Name               string `json:"name"`
+  FullyQualifiedName string `json:"fullyQualifiedName"`
+  Kind               string `json:"kind"`
+}
+
+// WriteSarif writes findings as a SARIF 2.1.0 log. Every signature becomes a
+// rule, and findings from signatures that are no longer loaded, such as in
+// an old session file, get a rule built from the finding itself.
+func WriteSarif(w io.Writer, findings []*Finding, signatures []Signature) error {
+  var rules []sarifRule
+  ruleIndexes := make(map[string]int)
+  for _, signature := range signatures {
+    if _, ok := ruleIndexes[signature.ID()]; ok {
+      continue
+    }
+    ruleIndexes[signature.ID()] = len(rules)
+    rules = append(rules, newSarifRule(signature.ID(), signature.Description(), signature.Comment(), signature.Severity(), signature.Confidence(), signature.Category(), signature.References()))
+  }
+
+  results := []sarifResult{}
+  baseIds := make(map[string]sarifArtifactLocation)
+  for _, finding := range findings {
+    repository := fmt.Sprintf("%s/%s", finding.RepositoryOwner, finding.RepositoryName)
+    if _, ok := baseIds[repository]; !ok {
+      baseIds[repository] = newSarifBaseLocation(finding, repository)
+    }
+    index, ok := ruleIndexes[finding.SignatureID]
+    if !ok {
+      index = len(rules)
+      ruleIndexes[finding.SignatureID] = index
+      rules = append(rules, newSarifRule(finding.SignatureID, finding.Description, finding.Comment, finding.Severity, finding.Confidence, finding.Category, finding.References))
+    }
+    results = append(results, newSarifResult(finding, index))
+  }
+
+  log := sarifLog{
+    Schema:  SarifSchema,
+    Version: SarifVersion,
+    Runs: []sarifRun{
+      {
+        Tool: sarifTool{
+          Driver: sarifDriver{
+            Name:           Name,
+            Version:        Version,
+            InformationUri: Website,
+            Rules:          rules,
+          },
+        },
+        OriginalUriBaseIds: baseIds,
+        Results:            results,
+      },
+    },
+  }
+  encoder := json.NewEncoder(w)
+  encoder.SetIndent("", "  ")
+  return encoder.Encode(log)
+}
+
+func newSarifRule(id string, description string, comment string, severity string, confidence string, category string, references []string) sarifRule {
+  rule := sarifRule{
+    Id:                   id,
+    ShortDescription:     sarifMessage{Text: description},
+    DefaultConfiguration: sarifConfiguration{Level: sarifLevel(severity)},
+    Properties: map[string]interface{}{
+      "severity":          severity,
+      "confidence":        confidence,
+      "category":          category,
+      "security-severity": sarifSecuritySeverities[severity],
+      "tags":              []string{"security", category},
+    },
+  }
+  if comment != "" {
+    rule.Help = &sarifMessage{Text: comment}
+  }
+  if len(references) > 0 {
+    rule.HelpUri = references[0]
+  }
+  return rule
+}
+
+func newSarifResult(finding *Finding, ruleIndex int) sarifResult {
+  repository := fmt.Sprintf("%s/%s", finding.RepositoryOwner, finding.RepositoryName)
+  location := sarifLocation{
+    PhysicalLocation: sarifPhysicalLocation{
+      ArtifactLocation: sarifArtifactLocation{Uri: finding.FilePath, UriBaseId: repository},
+    },
+    LogicalLocations: []sarifLogicalLocation{
+      {
+        Name:               finding.RepositoryName,
+        FullyQualifiedName: repository,
+        Kind:               "module",
+      },
+    },
+  }
+  if finding.LineNumber > 0 {
+    location.PhysicalLocation.Region = &sarifRegion{
+      StartLine: finding.LineNumber,
+      Snippet:   &sarifMessage{Text: finding.Snippet},
+    }
+  }
+  properties := map[string]interface{}{
+    "repository":    repository,
+    "commit":        finding.CommitHash,
+    "commitMessage": finding.CommitMessage,
+    "commitAuthor":  finding.CommitAuthor,
+    "action":        finding.Action,
+  }
+  if finding.OldPath != "" {
+    properties["oldPath"] = finding.OldPath
+  }
+  if len(finding.Refs) > 0 {
+    properties["refs"] = finding.Refs
+  }
+  if !finding.Local {
+    properties["repositoryUrl"] = finding.RepositoryUrl
+    properties["fileUrl"] = finding.FileUrl
+    properties["commitUrl"] = finding.CommitUrl
+  }
+  return sarifResult{
+    RuleId:    finding.SignatureID,
+    RuleIndex: ruleIndex,
+    Level:     sarifLevel(finding.Severity),
+    Message: sarifMessage{
+      Text: fmt.Sprintf("%s in %s at commit %s", finding.Description, repository, finding.CommitHash),
+    },
+    Locations:           []sarifLocation{location},
+    PartialFingerprints: map[string]string{"gitrobFindingId/v1": finding.Id},
+    Properties:          properties,
+  }
+}
+
+// newSarifBaseLocation returns the root of a repository that the paths of
+// its findings are relative to, so that files with the same path in
+// different repositories are told apart. Local repositories have no URL and
+// are only described by name.
+func newSarifBaseLocation(finding *Finding, repository string) sarifArtifactLocation {
+  location := sarifArtifactLocation{
+    Description: &sarifMessage{Text: repository},
+  }
+  if !finding.Local && finding.RepositoryUrl != "" {
+    location.Uri = strings.TrimSuffix(finding.RepositoryUrl, "/") + "/"
+  }
+  return location
+}
+
+func sarifLevel(severity string) string {
+  if level, ok := sarifLevels[severity]; ok {
+    return level
+  }
+  return "warning"
+}
@@ -2,12 +2,16 @@ package core
 
 import (
   "context"
+  "crypto/tls"
   "encoding/json"
   "errors"
   "fmt"
   "io/ioutil"
+  "net"
   "os"
   "runtime"
+  "strconv"
+  "strings"
   "sync"
   "time"
 
@@ -17,12 +21,14 @@ import (
 )
 
 const (
-  AccessTokenEnvVariable = "GITROB_ACCESS_TOKEN"
+  AccessTokenEnvVariable       = "GITROB_ACCESS_TOKEN"
+  GitlabAccessTokenEnvVariable = "GITROB_GITLAB_ACCESS_TOKEN"
 
   StatusInitializing = "initializing"
   StatusGathering    = "gathering"
   StatusAnalyzing    = "analyzing"
   StatusFinished     = "finished"
+  StatusInterrupted  = "interrupted"
 )
 
 type Stats struct {
@@ -37,30 +43,65 @@ type Stats struct {
   Commits      int
   Files        int
   Findings     int
+  Suppressed   int
+  Errors       int
+
+  RateLimit          int
+  RateLimitRemaining int
+  RateLimitReset     time.Time
 }
 
 type Session struct {
   sync.Mutex
+  saveLock   sync.Mutex
+  findingIds map[string]bool
 
   Version           string
   Options           Options `json:"-"`
   Out               *Logger `json:"-"`
   Stats             *Stats
-  GithubAccessToken string         `json:"-"`
-  GithubClient      *github.Client `json:"-"`
-  Router            *gin.Engine    `json:"-"`
-  Targets           []*GithubOwner
-  Repositories      []*GithubRepository
+  GithubAccessToken string           `json:"-"`
+  GithubClient      *github.Client   `json:"-"`
+  GitlabAccessToken string           `json:"-"`
+  Provider          Provider         `json:"-"`
+  Router            *gin.Engine      `json:"-"`
+  AuthToken         string           `json:"-"`
+  Credentials       Credentials      `json:"-"`
+  TLSCertificate    *tls.Certificate `json:"-"`
+  SelfSigned        bool             `json:"-"`
+  Signatures        []Signature      `json:"-"`
+  Allowlist         *Allowlist       `json:"-"`
+  Diff              *SessionDiff     `json:"-"`
+  Targets           []*Owner
+  Repositories      []*Repository
   Findings          []*Finding
+  Errors            []*AnalysisError
+}
+
+// AnalysisError is an error that occurred while analyzing a commit or one
+// of its files. The path is empty if the whole commit couldn't be analyzed.
+type AnalysisError struct {
+  RepositoryOwner string
+  RepositoryName  string
+  CommitHash      string
+  FilePath        string
+  Message         string
 }
 
 func (s *Session) Start() {
   s.InitStats()
   s.InitLogger()
   s.InitThreads()
-  s.InitGithubAccessToken()
-  s.InitGithubClient()
-  s.InitRouter()
+  s.InitSignatures()
+  s.InitAllowlist()
+  if !*s.Options.Local {
+    s.InitProvider()
+  }
+  if !*s.Options.NoServer {
+    s.InitAuth()
+    s.InitTLS()
+    s.InitRouter()
+  }
 }
 
 func (s *Session) Finish() {
@@ -68,7 +109,7 @@ func (s *Session) Finish() {
   s.Stats.Status = StatusFinished
 }
 
-func (s *Session) AddTarget(target *GithubOwner) {
+func (s *Session) AddTarget(target *Owner) {
   s.Lock()
   defer s.Unlock()
   for _, t := range s.Targets {
@@ -79,7 +120,7 @@ func (s *Session) AddTarget(target *GithubOwner) {
   s.Targets = append(s.Targets, target)
 }
 
-func (s *Session) AddRepository(repository *GithubRepository) {
+func (s *Session) AddRepository(repository *Repository) {
   s.Lock()
   defer s.Unlock()
   for _, r := range s.Repositories {
@@ -90,10 +131,94 @@ func (s *Session) AddRepository(repository *GithubRepository) {
   s.Repositories = append(s.Repositories, repository)
 }
 
-func (s *Session) AddFinding(finding *Finding) {
+func (s *Session) FindRepository(owner string, name string) *Repository {
+  s.Lock()
+  defer s.Unlock()
+  return s.findRepository(owner, name)
+}
+
+func (s *Session) findRepository(owner string, name string) *Repository {
+  for _, r := range s.Repositories {
+    if *r.Owner == owner && *r.Name == name {
+      return r
+    }
+  }
+  return nil
+}
+
+// AddFinding adds a finding unless it has already been added, which happens
+// when a repository that was partially analyzed before a scan was interrupted
+// is analyzed again. It returns true if the finding was added.
+func (s *Session) AddFinding(finding *Finding) bool {
   s.Lock()
   defer s.Unlock()
+  if s.findingIds == nil {
+    s.findingIds = make(map[string]bool, len(s.Findings))
+    for _, f := range s.Findings {
+      s.findingIds[f.Id] = true
+    }
+  }
+  if s.findingIds[finding.Id] {
+    return false
+  }
+  s.findingIds[finding.Id] = true
   s.Findings = append(s.Findings, finding)
+  return true
+}
+
+// AddError records an error that occurred while analyzing a commit or file.
+func (s *Session) AddError(repository *Repository, commit string, path string, err error) {
+  s.Lock()
+  defer s.Unlock()
+  s.Errors = append(s.Errors, &AnalysisError{
+    RepositoryOwner: *repository.Owner,
+    RepositoryName:  *repository.Name,
+    CommitHash:      commit,
+    FilePath:        path,
+    Message:         err.Error(),
+  })
+  s.Stats.IncrementErrors()
+}
+
+// MarkRepositoryAnalyzed records that a repository was fully analyzed, along
+// with the number of commits and files in it and of findings the allowlist
+// suppressed, so a resumed scan can count them without analyzing the
+// repository again.
+func (s *Session) MarkRepositoryAnalyzed(repository *Repository, commits int, files int, suppressed int) {
+  s.Lock()
+  defer s.Unlock()
+  repository.Analyzed = true
+  repository.Commits = commits
+  repository.Files = files
+  repository.Suppressed = suppressed
+}
+
+// RemainingRepositories returns the repositories that have not been fully
+// analyzed yet.
+func (s *Session) RemainingRepositories() []*Repository {
+  s.Lock()
+  defer s.Unlock()
+  var remaining []*Repository
+  for _, r := range s.Repositories {
+    if !r.Analyzed {
+      remaining = append(remaining, r)
+    }
+  }
+  return remaining
+}
+
+// CountFindingsAtOrAbove returns the number of findings with the given
+// severity or higher.
+func (s *Session) CountFindingsAtOrAbove(severity string) int {
+  s.Lock()
+  defer s.Unlock()
+  count := 0
+  for _, f := range s.Findings {
+    if SeverityRank(f.Severity) >= SeverityRank(severity) {
+      count++
+    }
+  }
+  return count
 }
 
 func (s *Session) InitStats() {
@@ -109,6 +234,7 @@ func (s *Session) InitStats() {
     Commits:      0,
     Files:        0,
     Findings:     0,
+    Suppressed:   0,
   }
 }
 
@@ -130,6 +256,40 @@ func (s *Session) InitGithubAccessToken() {
   }
 }
 
+func (s *Session) InitGitlabAccessToken() {
+  if *s.Options.GitlabAccessToken == "" {
+    accessToken := os.Getenv(GitlabAccessTokenEnvVariable)
+    if accessToken == "" {
+      s.Out.Fatal("No GitLab access token given. Please provide via command line option or in the %s environment variable.\n", GitlabAccessTokenEnvVariable)
+    }
+    s.GitlabAccessToken = accessToken
+  } else {
+    s.GitlabAccessToken = *s.Options.GitlabAccessToken
+  }
+}
+
+func (s *Session) InitProvider() {
+  switch *s.Options.Provider {
+  case ProviderGithub:
+    s.InitGithubAccessToken()
+    s.InitGithubClient()
+    webURL := *s.Options.GithubURL
+    if webURL == "" {
+      if *s.Options.GithubAPIURL != "" {
+        webURL = fmt.Sprintf("%s://%s", s.GithubClient.BaseURL.Scheme, s.GithubClient.BaseURL.Host)
+      } else {
+        webURL = DefaultGithubURL
+      }
+    }
+    s.Provider = NewGithubProvider(s.GithubClient, webURL, s.GithubAccessToken, *s.Options.IncludeForks, s.Out, s.Stats)
+  case ProviderGitlab:
+    s.InitGitlabAccessToken()
+    s.Provider = NewGitlabProvider(*s.Options.GitlabURL, s.GitlabAccessToken, *s.Options.IncludeForks, s.Out, s.Stats)
+  default:
+    s.Out.Fatal("Unknown provider: %s. Valid providers are %s and %s.\n", *s.Options.Provider, ProviderGithub, ProviderGitlab)
+  }
+}
+
 func (s *Session) InitGithubClient() {
   ctx := context.Background()
   ts := oauth2.StaticTokenSource(
@@ -138,6 +298,21 @@ func (s *Session) InitGithubClient() {
   tc := oauth2.NewClient(ctx, ts)
   s.GithubClient = github.NewClient(tc)
   s.GithubClient.UserAgent = fmt.Sprintf("%s v%s", Name, Version)
+  if *s.Options.GithubAPIURL == "" {
+    return
+  }
+  apiURL, err := parseBaseURL(*s.Options.GithubAPIURL)
+  if err != nil {
+    s.Out.Fatal("Invalid GitHub API URL: %s\n", err)
+  }
+  uploadURL := *s.Options.GithubUploadURL
+  if uploadURL == "" {
+    uploadURL = strings.Replace(apiURL.String(), "/api/v3/", "/api/uploads/", 1)
+  }
+  s.GithubClient.BaseURL = apiURL
+  if s.GithubClient.UploadURL, err = parseBaseURL(uploadURL); err != nil {
+    s.Out.Fatal("Invalid GitHub upload URL: %s\n", err)
+  }
 }
 
 func (s *Session) InitThreads() {
@@ -148,28 +323,209 @@ func (s *Session) InitThreads() {
   runtime.GOMAXPROCS(*s.Options.Threads + 2) // thread count + main + web server
 }
 
+func (s *Session) InitSignatures() {
+  defined := make(map[string]string)
+  var entropySignatures []Signature
+  if !*s.Options.NoDefaultSignatures {
+    entropySignatures = NewEntropySignatures(*s.Options.EntropyThreshold)
+    s.Signatures = append(s.Signatures, Signatures...)
+    for _, signatures := range [][]Signature{Signatures, entropySignatures} {
+      for _, signature := range signatures {
+        defined[signature.ID()] = "the default signatures"
+      }
+    }
+  }
+  if *s.Options.SignatureFiles != "" {
+    for _, file := range strings.Split(*s.Options.SignatureFiles, ",") {
+      signatures, err := LoadSignaturesFile(strings.TrimSpace(file), defined)
+      if err != nil {
+        s.Out.Fatal("Error loading signatures: %s\n", err)
+      }
+      s.Signatures = append(s.Signatures, signatures...)
+    }
+  }
+  s.Signatures = append(s.Signatures, entropySignatures...)
+  if len(s.Signatures) == 0 {
+    s.Out.Fatal("No signatures loaded. Please provide signature files with -signatures when using -no-default-signatures.\n")
+  }
+}
+
+func (s *Session) InitAllowlist() {
+  if *s.Options.Allowlist == "" {
+    return
+  }
+  allowlist, err := LoadAllowlistFile(*s.Options.Allowlist)
+  if err != nil {
+    s.Out.Fatal("Error loading allowlist: %s\n", err)
+  }
+  s.Allowlist = allowlist
+}
+
+func (s *Session) InitAuth() {
+  if *s.Options.AuthToken {
+    token, err := GenerateAuthToken()
+    if err != nil {
+      s.Out.Fatal("Error generating authentication token: %s\n", err)
+    }
+    s.AuthToken = token
+  }
+  if *s.Options.AuthFile != "" {
+    credentials, err := LoadCredentialsFile(*s.Options.AuthFile)
+    if err != nil {
+      s.Out.Fatal("Error loading authentication file: %s\n", err)
+    }
+    s.Credentials = credentials
+  }
+}
+
+// IsAuthRequired reports whether the web interface and API require
+// authentication.
+func (s *Session) IsAuthRequired() bool {
+  return s.AuthToken != "" || s.Credentials != nil
+}
+
+func (s *Session) InitTLS() {
+  if *s.Options.TLSCert != "" {
+    certificate, err := tls.LoadX509KeyPair(*s.Options.TLSCert, *s.Options.TLSKey)
+    if err != nil {
+      s.Out.Fatal("Error loading TLS certificate: %s\n", err)
+    }
+    s.TLSCertificate = &certificate
+  } else if *s.Options.TLSSelfSigned {
+    certificate, err := GenerateSelfSignedCertificate(SelfSignedCertificateHosts(*s.Options.BindAddress))
+    if err != nil {
+      s.Out.Fatal("Error generating self-signed TLS certificate: %s\n", err)
+    }
+    s.TLSCertificate = &certificate
+    s.SelfSigned = true
+  }
+}
+
+// WebURL returns the base URL of the web interface.
+func (s *Session) WebURL() string {
+  scheme := "http"
+  if s.TLSCertificate != nil {
+    scheme = "https"
+  }
+  return fmt.Sprintf("%s://%s/", scheme, net.JoinHostPort(*s.Options.BindAddress, strconv.Itoa(*s.Options.Port)))
+}
+
 func (s *Session) InitRouter() {
   bind := fmt.Sprintf("%s:%d", *s.Options.BindAddress, *s.Options.Port)
   s.Router = NewRouter(s)
   go func(sess *Session) {
-    if err := sess.Router.Run(bind); err != nil {
+    var err error
+    if sess.TLSCertificate != nil {
+      err = NewTLSServer(bind, sess.Router, *sess.TLSCertificate).ListenAndServeTLS("", "")
+    } else {
+      err = sess.Router.Run(bind)
+    }
+    if err != nil {
       sess.Out.Fatal("Error when starting web server: %s\n", err)
     }
   }(s)
 }
 
+// SaveToFile writes the session to a temporary file next to location and
+// renames it into place, so an interrupted write never leaves a corrupt
+// session file behind.
 func (s *Session) SaveToFile(location string) error {
+  s.saveLock.Lock()
+  defer s.saveLock.Unlock()
+  s.Lock()
+  s.Stats.Lock()
   sessionJson, err := json.Marshal(s)
+  s.Stats.Unlock()
+  s.Unlock()
+  if err != nil {
+    return err
+  }
+  tmpLocation := location + ".tmp"
+  err = ioutil.WriteFile(tmpLocation, sessionJson, 0644)
   if err != nil {
     return err
   }
-  err = ioutil.WriteFile(location, sessionJson, 0644)
+  return os.Rename(tmpLocation, location)
+}
+
+func (s *Session) loadFromFile(location string) error {
+  if !FileExists(location) {
+    return errors.New(fmt.Sprintf("Session file %s does not exist or is not readable.", location))
+  }
+  data, err := ioutil.ReadFile(location)
   if err != nil {
     return err
   }
+  if err := json.Unmarshal(data, s); err != nil {
+    return errors.New(fmt.Sprintf("Session file %s is corrupt or generated by an old version of Gitrob.", location))
+  }
   return nil
 }
 
+// StartCheckpointing periodically saves the session to the -save file while
+// repositories are being analyzed.
+func (s *Session) StartCheckpointing() {
+  if *s.Options.Save == "" || *s.Options.CheckpointInterval <= 0 {
+    return
+  }
+  ticker := time.NewTicker(time.Duration(*s.Options.CheckpointInterval) * time.Second)
+  go func() {
+    for range ticker.C {
+      if s.Stats.Status != StatusAnalyzing {
+        ticker.Stop()
+        return
+      }
+      if err := s.SaveToFile(*s.Options.Save); err != nil {
+        s.Out.Error("Error saving checkpoint to %s: %s\n", *s.Options.Save, err)
+        continue
+      }
+      s.Out.Debug("Saved checkpoint to %s\n", *s.Options.Save)
+    }
+  }()
+}
+
+// Interrupt marks an unfinished scan as interrupted and saves it to the
+// -save file so it can be continued with -resume.
+func (s *Session) Interrupt() error {
+  s.Stats.Status = StatusInterrupted
+  s.Stats.FinishedAt = time.Now()
+  if *s.Options.Save == "" {
+    return nil
+  }
+  return s.SaveToFile(*s.Options.Save)
+}
+
+// prepareResume resets the statistics of a checkpoint to the repositories
+// that were fully analyzed, and drops the errors of the others, since they
+// are analyzed again from the start.
+func (s *Session) prepareResume() {
+  analyzed, commits, files, suppressed := 0, 0, 0, 0
+  for _, r := range s.Repositories {
+    if r.Analyzed {
+      analyzed++
+      commits += r.Commits
+      files += r.Files
+      suppressed += r.Suppressed
+    }
+  }
+  var analysisErrors []*AnalysisError
+  for _, e := range s.Errors {
+    if r := s.findRepository(e.RepositoryOwner, e.RepositoryName); r != nil && r.Analyzed {
+      analysisErrors = append(analysisErrors, e)
+    }
+  }
+  s.Errors = analysisErrors
+  s.Stats.Status = StatusInitializing
+  s.Stats.FinishedAt = time.Time{}
+  s.Stats.Repositories = analyzed
+  s.Stats.Commits = commits
+  s.Stats.Files = files
+  s.Stats.Suppressed = suppressed
+  s.Stats.Findings = len(s.Findings)
+  s.Stats.Errors = len(s.Errors)
+  s.Stats.UpdateProgress(analyzed, len(s.Repositories))
+}
+
 func (s *Stats) IncrementTargets() {
   s.Lock()
   defer s.Unlock()
@@ -194,12 +550,32 @@ func (s *Stats) IncrementFiles() {
   s.Files++
 }
 
+func (s *Stats) IncrementErrors() {
+  s.Lock()
+  defer s.Unlock()
+  s.Errors++
+}
+
 func (s *Stats) IncrementFindings() {
   s.Lock()
   defer s.Unlock()
   s.Findings++
 }
 
+func (s *Stats) IncrementSuppressed() {
+  s.Lock()
+  defer s.Unlock()
+  s.Suppressed++
+}
+
+func (s *Stats) UpdateRateLimit(limit int, remaining int, reset time.Time) {
+  s.Lock()
+  defer s.Unlock()
+  s.RateLimit = limit
+  s.RateLimitRemaining = remaining
+  s.RateLimitReset = reset
+}
+
 func (s *Stats) UpdateProgress(current int, total int) {
   s.Lock()
   defer s.Unlock()
@@ -218,20 +594,72 @@ func NewSession() (*Session, error) {
     return nil, err
   }
 
-  if *session.Options.Save != "" && FileExists(*session.Options.Save) {
+  if *session.Options.Resume != "" {
+    if *session.Options.Load != "" {
+      return nil, errors.New("The -load and -resume options can't be used together.")
+    }
+    if err := session.loadFromFile(*session.Options.Resume); err != nil {
+      return nil, err
+    }
+    if session.Stats == nil || session.Stats.Status == StatusFinished {
+      return nil, errors.New(fmt.Sprintf("Session file %s has no unfinished scan to resume. Use -load to browse it.", *session.Options.Resume))
+    }
+    if *session.Options.Save == "" {
+      session.Options.Save = session.Options.Resume
+    }
+    session.prepareResume()
+  }
+
+  if *session.Options.Save != "" && *session.Options.Save != *session.Options.Resume && FileExists(*session.Options.Save) {
     return nil, errors.New(fmt.Sprintf("File: %s already exists.", *session.Options.Save))
   }
 
-  if *session.Options.Load != "" {
-    if !FileExists(*session.Options.Load) {
-      return nil, errors.New(fmt.Sprintf("Session file %s does not exist or is not readable.", *session.Options.Load))
+  if *session.Options.Hook != "" {
+    if !IsValidHook(*session.Options.Hook) {
+      return nil, errors.New(fmt.Sprintf("Unknown hook: %s. Valid hooks are %s and %s.", *session.Options.Hook, HookPreCommit, HookPrePush))
     }
-    data, err := ioutil.ReadFile(*session.Options.Load)
+    *session.Options.Local = true
+    *session.Options.NoServer = true
+  }
+
+  if *session.Options.CI {
+    *session.Options.NoServer = true
+  }
+
+  if (*session.Options.TLSCert == "") != (*session.Options.TLSKey == "") {
+    return nil, errors.New("The -tls-cert and -tls-key options must be used together.")
+  }
+
+  if *session.Options.TLSSelfSigned && *session.Options.TLSCert != "" {
+    return nil, errors.New("The -tls-self-signed option can't be used with -tls-cert and -tls-key.")
+  }
+
+  if *session.Options.AuthToken && *session.Options.Silent && !*session.Options.NoServer {
+    return nil, errors.New("The -auth-token option can't be used with -silent since the token is printed at startup.")
+  }
+
+  if SeverityRank(*session.Options.FailSeverity) == 0 {
+    return nil, errors.New(fmt.Sprintf("Unknown severity for -fail-severity: %s. Valid severities are critical, high, medium and low.", *session.Options.FailSeverity))
+  }
+
+  if *session.Options.Export != "" {
+    format, err := ExportFormat(*session.Options.ExportFormat, *session.Options.Export)
     if err != nil {
-      return nil, err
+      return nil, errors.New(fmt.Sprintf("Invalid export options: %s.", err))
+    }
+    if *session.Options.ExportTables && format == ExportFormatSarif {
+      return nil, errors.New("The -export-tables option can only be used with the csv and ndjson export formats.")
     }
-    if err := json.Unmarshal(data, &session); err != nil {
-      return nil, errors.New(fmt.Sprintf("Session file %s is corrupt or generated by an old version of Gitrob.", *session.Options.Load))
+    session.Options.ExportFormat = &format
+  }
+
+  if *session.Options.Diff != "" && !FileExists(*session.Options.Diff) {
+    return nil, errors.New(fmt.Sprintf("Session file %s does not exist or is not readable.", *session.Options.Diff))
+  }
+
+  if *session.Options.Load != "" {
+    if err := session.loadFromFile(*session.Options.Load); err != nil {
+      return nil, err
     }
   }
 
@@ -2,29 +2,94 @@ package core
 
 import (
   "crypto/sha1"
+  "errors"
   "fmt"
   "io"
+  "io/ioutil"
   "path/filepath"
   "regexp"
   "strings"
+  "time"
+
+  "gopkg.in/yaml.v3"
 )
 
 const (
   TypeSimple  = "simple"
   TypePattern = "pattern"
+  TypeContent = "content"
 
   PartExtension = "extension"
   PartFilename  = "filename"
   PartPath      = "path"
+  PartContent   = "content"
+
+  SeverityCritical = "critical"
+  SeverityHigh     = "high"
+  SeverityMedium   = "medium"
+  SeverityLow      = "low"
+
+  ConfidenceHigh   = "high"
+  ConfidenceMedium = "medium"
+  ConfidenceLow    = "low"
+
+  CategoryCrypto        = "crypto"
+  CategoryCloud         = "cloud"
+  CategoryDatabase      = "database"
+  CategoryCredentials   = "credentials"
+  CategoryConfiguration = "configuration"
+  CategoryHistory       = "history"
+  CategoryNetwork       = "network"
+  CategoryPasswords     = "passwords"
+  CategoryLogs          = "logs"
+  CategoryPersonal      = "personal"
+  CategoryTokens        = "tokens"
+  CategoryOther         = "other"
 )
 
+var severityRanks = map[string]int{
+  SeverityLow:      1,
+  SeverityMedium:   2,
+  SeverityHigh:     3,
+  SeverityCritical: 4,
+}
+
 var skippableExtensions = []string{".jpg", ".jpeg", ".png", ".gif", ".bmp", ".tiff", ".tif", ".psd", ".xcf"}
 var skippablePathIndicators = []string{"node_modules/", "vendor/bundle", "vendor/cache"}
+var gistAnchorRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// SeverityRank returns a number for ordering severities from low to critical,
+// or 0 if the severity is unknown.
+func SeverityRank(severity string) int {
+  return severityRanks[severity]
+}
+
+func IsValidConfidence(confidence string) bool {
+  switch confidence {
+  case ConfidenceHigh, ConfidenceMedium, ConfidenceLow:
+    return true
+  }
+  return false
+}
 
 type MatchFile struct {
   Path      string
+  OldPath   string
+  Action    string
   Filename  string
   Extension string
+  Contents  []byte
+  Additions []MatchLine
+}
+
+type MatchLine struct {
+  LineNumber int
+  Content    string
+}
+
+type ContentMatch struct {
+  LineNumber int
+  Snippet    string
 }
 
 func (f *MatchFile) IsSkippable() bool {
@@ -46,27 +111,69 @@ func (f *MatchFile) IsSkippable() bool {
 type Finding struct {
   Id              string
   FilePath        string
+  OldPath         string
   Action          string
+  SignatureID     string
   Description     string
   Comment         string
+  Severity        string
+  Confidence      string
+  Category        string
+  References      []string
   RepositoryOwner string
   RepositoryName  string
   CommitHash      string
   CommitMessage   string
   CommitAuthor    string
+  CommitDate      time.Time
+  Refs            []string
+  LineNumber      int
+  Snippet         string
   FileUrl         string
   CommitUrl       string
   RepositoryUrl   string
+  TriageStatus    string
+  Assignee        string
+  Notes           string
+  Gist            bool
+  Wiki            bool
+  Local           bool
 }
 
 func (f *Finding) setupUrls() {
-  f.RepositoryUrl = fmt.Sprintf("https://github.com/%s/%s", f.RepositoryOwner, f.RepositoryName)
+  if f.Local {
+    return
+  }
+  if f.RepositoryUrl == "" {
+    f.RepositoryUrl = fmt.Sprintf("%s/%s/%s", DefaultGithubURL, f.RepositoryOwner, f.RepositoryName)
+  }
+  if f.Gist {
+    f.CommitUrl = fmt.Sprintf("%s/%s", f.RepositoryUrl, f.CommitHash)
+    f.FileUrl = fmt.Sprintf("%s#file-%s", f.CommitUrl, gistFileAnchor(f.FilePath))
+    return
+  }
+  if f.Wiki {
+    page := strings.TrimSuffix(filepath.Base(f.FilePath), filepath.Ext(f.FilePath))
+    f.FileUrl = fmt.Sprintf("%s/%s/%s", f.RepositoryUrl, page, f.CommitHash)
+    f.CommitUrl = fmt.Sprintf("%s/_compare/%s", f.RepositoryUrl, f.CommitHash)
+    return
+  }
   f.FileUrl = fmt.Sprintf("%s/blob/%s/%s", f.RepositoryUrl, f.CommitHash, f.FilePath)
   f.CommitUrl = fmt.Sprintf("%s/commit/%s", f.RepositoryUrl, f.CommitHash)
 }
 
+// gistFileAnchor returns the anchor of a file on a gist page, which is its
+// lowercased name with everything but letters and digits replaced by dashes.
+func gistFileAnchor(path string) string {
+  return strings.Trim(gistAnchorRegex.ReplaceAllString(strings.ToLower(path), "-"), "-")
+}
+
+// generateID derives the ID of the finding from the signature and the file
+// and commit it was found in, so that findings of different signatures in
+// the same file have different IDs.
 func (f *Finding) generateID() {
   h := sha1.New()
+  io.WriteString(h, f.SignatureID)
   io.WriteString(h, f.FilePath)
   io.WriteString(h, f.Action)
   io.WriteString(h, f.RepositoryOwner)
@@ -84,22 +191,52 @@ func (f *Finding) Initialize() {
 
 type Signature interface {
   Match(file MatchFile) bool
+  ID() string
   Description() string
   Comment() string
+  Severity() string
+  Confidence() string
+  Category() string
+  References() []string
+}
+
+type ContentMatcher interface {
+  FindMatch(file MatchFile) *ContentMatch
 }
 
 type SimpleSignature struct {
+  id          string
   part        string
   match       string
   description string
   comment     string
+  severity    string
+  confidence  string
+  category    string
+  references  []string
 }
 
 type PatternSignature struct {
+  id          string
   part        string
   match       *regexp.Regexp
   description string
   comment     string
+  severity    string
+  confidence  string
+  category    string
+  references  []string
+}
+
+type ContentSignature struct {
+  id          string
+  match       *regexp.Regexp
+  description string
+  comment     string
+  severity    string
+  confidence  string
+  category    string
+  references  []string
 }
 
 func (s SimpleSignature) Match(file MatchFile) bool {
@@ -118,6 +255,10 @@ func (s SimpleSignature) Match(file MatchFile) bool {
   return (s.match == *haystack)
 }
 
+func (s SimpleSignature) ID() string {
+  return s.id
+}
+
 func (s SimpleSignature) Description() string {
   return s.description
 }
@@ -126,6 +267,22 @@ func (s SimpleSignature) Comment() string {
   return s.comment
 }
 
+func (s SimpleSignature) Severity() string {
+  return s.severity
+}
+
+func (s SimpleSignature) Confidence() string {
+  return s.confidence
+}
+
+func (s SimpleSignature) Category() string {
+  return s.category
+}
+
+func (s SimpleSignature) References() []string {
+  return s.references
+}
+
 func (s PatternSignature) Match(file MatchFile) bool {
   var haystack *string
   switch s.part {
@@ -142,6 +299,10 @@ func (s PatternSignature) Match(file MatchFile) bool {
   return s.match.MatchString(*haystack)
 }
 
+func (s PatternSignature) ID() string {
+  return s.id
+}
+
 func (s PatternSignature) Description() string {
   return s.description
 }
@@ -150,6 +311,89 @@ func (s PatternSignature) Comment() string {
   return s.comment
 }
 
+func (s PatternSignature) Severity() string {
+  return s.severity
+}
+
+func (s PatternSignature) Confidence() string {
+  return s.confidence
+}
+
+func (s PatternSignature) Category() string {
+  return s.category
+}
+
+func (s PatternSignature) References() []string {
+  return s.references
+}
+
+func (s ContentSignature) Match(file MatchFile) bool {
+  return s.FindMatch(file) != nil
+}
+
+// FindMatch returns the first line of the file that matches the signature.
+// Only the added lines of modified, renamed and copied files are searched,
+// so a secret is reported by the commit that introduced it rather than by
+// every later change to the file.
+func (s ContentSignature) FindMatch(file MatchFile) *ContentMatch {
+  if file.Action != "Insert" {
+    for _, line := range file.Additions {
+      if match := s.findLineMatch(line.LineNumber, line.Content); match != nil {
+        return match
+      }
+    }
+    return nil
+  }
+  if len(file.Contents) == 0 {
+    return nil
+  }
+  for i, line := range NewlineRegex.Split(string(file.Contents), -1) {
+    if match := s.findLineMatch(i+1, line); match != nil {
+      return match
+    }
+  }
+  return nil
+}
+
+func (s ContentSignature) findLineMatch(lineNumber int, line string) *ContentMatch {
+  loc := s.match.FindStringIndex(line)
+  if loc == nil {
+    return nil
+  }
+  return &ContentMatch{
+    LineNumber: lineNumber,
+    Snippet:    TruncateString(RedactString(line, loc[0], loc[1]), 100),
+  }
+}
+
+func (s ContentSignature) ID() string {
+  return s.id
+}
+
+func (s ContentSignature) Description() string {
+  return s.description
+}
+
+func (s ContentSignature) Comment() string {
+  return s.comment
+}
+
+func (s ContentSignature) Severity() string {
+  return s.severity
+}
+
+func (s ContentSignature) Confidence() string {
+  return s.confidence
+}
+
+func (s ContentSignature) Category() string {
+  return s.category
+}
+
+func (s ContentSignature) References() []string {
+  return s.references
+}
+
 func NewMatchFile(path string) MatchFile {
   _, filename := filepath.Split(path)
   extension := filepath.Ext(path)
@@ -160,551 +404,1145 @@ func NewMatchFile(path string) MatchFile {
   }
 }
 
+type SignatureDefinition struct {
+  ID          string   `yaml:"id"`
+  Type        string   `yaml:"type"`
+  Part        string   `yaml:"part"`
+  Match       string   `yaml:"match"`
+  Description string   `yaml:"description"`
+  Comment     string   `yaml:"comment"`
+  Severity    string   `yaml:"severity"`
+  Confidence  string   `yaml:"confidence"`
+  Category    string   `yaml:"category"`
+  References  []string `yaml:"references"`
+}
+
+func (d SignatureDefinition) Signature() (Signature, error) {
+  if d.ID == "" {
+    return nil, errors.New("id is missing")
+  }
+  if d.Type == TypeContent {
+    if d.Part == "" {
+      d.Part = PartContent
+    } else if d.Part != PartContent {
+      return nil, errors.New(fmt.Sprintf("content signatures can't match part %q", d.Part))
+    }
+  }
+  switch d.Part {
+  case PartExtension, PartFilename, PartPath, PartContent:
+  default:
+    return nil, errors.New(fmt.Sprintf("unknown part: %q", d.Part))
+  }
+  if d.Type != TypeSimple && d.Type != TypePattern && d.Type != TypeContent {
+    return nil, errors.New(fmt.Sprintf("unknown type: %q", d.Type))
+  }
+  if d.Match == "" {
+    return nil, errors.New("match is missing")
+  }
+  if d.Description == "" {
+    return nil, errors.New("description is missing")
+  }
+  if d.Severity == "" {
+    d.Severity = SeverityMedium
+  } else if SeverityRank(d.Severity) == 0 {
+    return nil, errors.New(fmt.Sprintf("unknown severity: %q", d.Severity))
+  }
+  if d.Confidence == "" {
+    d.Confidence = ConfidenceMedium
+  } else if !IsValidConfidence(d.Confidence) {
+    return nil, errors.New(fmt.Sprintf("unknown confidence: %q", d.Confidence))
+  }
+  if d.Category == "" {
+    d.Category = CategoryOther
+  }
+
+  if d.Type == TypeSimple && d.Part != PartContent {
+    return SimpleSignature{
+      id:          d.ID,
+      part:        d.Part,
+      match:       d.Match,
+      description: d.Description,
+      comment:     d.Comment,
+      severity:    d.Severity,
+      confidence:  d.Confidence,
+      category:    d.Category,
+      references:  d.References,
+    }, nil
+  }
+  pattern := d.Match
+  if d.Type == TypeSimple {
+    pattern = regexp.QuoteMeta(pattern)
+  }
+  match, err := regexp.Compile(pattern)
+  if err != nil {
+    return nil, errors.New(fmt.Sprintf("invalid regular expression: %s", err))
+  }
+  if d.Part == PartContent {
+    return ContentSignature{
+      id:          d.ID,
+      match:       match,
+      description: d.Description,
+      comment:     d.Comment,
+      severity:    d.Severity,
+      confidence:  d.Confidence,
+      category:    d.Category,
+      references:  d.References,
+    }, nil
+  }
+  return PatternSignature{
+    id:          d.ID,
+    part:        d.Part,
+    match:       match,
+    description: d.Description,
+    comment:     d.Comment,
+    severity:    d.Severity,
+    confidence:  d.Confidence,
+    category:    d.Category,
+    references:  d.References,
+  }, nil
+}
+
+// LoadSignaturesFile reads a list of signature definitions from a YAML or
+// JSON file. Errors are prefixed with the file path and line of the offending
+// definition. Defined maps the IDs of signatures that are already loaded to
+// where they were defined, and definitions that reuse one of them, or an ID
+// from earlier in the file, are rejected. The signatures in the file are
+// added to it.
+func LoadSignaturesFile(path string, defined map[string]string) ([]Signature, error) {
+  var signatures []Signature
+  var document yaml.Node
+  data, err := ioutil.ReadFile(path)
+  if err != nil {
+    return nil, err
+  }
+  if err := yaml.Unmarshal(data, &document); err != nil {
+    return nil, errors.New(fmt.Sprintf("%s: %s", path, err))
+  }
+  if len(document.Content) == 0 {
+    return signatures, nil
+  }
+  list := document.Content[0]
+  if list.Kind != yaml.SequenceNode {
+    return nil, errors.New(fmt.Sprintf("%s:%d: expected a list of signatures", path, list.Line))
+  }
+  for _, node := range list.Content {
+    var definition SignatureDefinition
+    if err := node.Decode(&definition); err != nil {
+      return nil, errors.New(fmt.Sprintf("%s:%d: %s", path, node.Line, err))
+    }
+    signature, err := definition.Signature()
+    if err != nil {
+      return nil, errors.New(fmt.Sprintf("%s:%d: %s", path, node.Line, err))
+    }
+    if location, ok := defined[signature.ID()]; ok {
+      return nil, errors.New(fmt.Sprintf("%s:%d: duplicate id %q, already defined in %s", path, node.Line, signature.ID(), location))
+    }
+    defined[signature.ID()] = fmt.Sprintf("%s:%d", path, node.Line)
+    signatures = append(signatures, signature)
+  }
+  return signatures, nil
+}
+
 var Signatures = []Signature{
   SimpleSignature{
+    id:          "pem-file",
     part:        PartExtension,
     match:       ".pem",
     description: "Potential cryptographic private key",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "log-file",
     part:        PartExtension,
     match:       ".log",
     description: "Log file",
     comment:     "Log files can contain secret HTTP endpoints, session IDs, API keys and other goodies",
+    severity:    SeverityLow,
+    confidence:  ConfidenceLow,
+    category:    CategoryLogs,
   },
   SimpleSignature{
+    id:          "pkcs12-file",
     part:        PartExtension,
     match:       ".pkcs12",
     description: "Potential cryptographic key bundle",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "p12-file",
     part:        PartExtension,
     match:       ".p12",
     description: "Potential cryptographic key bundle",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "pfx-file",
     part:        PartExtension,
     match:       ".pfx",
     description: "Potential cryptographic key bundle",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "asc-file",
     part:        PartExtension,
     match:       ".asc",
     description: "Potential cryptographic key bundle",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceLow,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "pidgin-otr-private-key",
     part:        PartFilename,
     match:       "otr.private_key",
     description: "Pidgin OTR private key",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "openvpn-config",
     part:        PartExtension,
     match:       ".ovpn",
     description: "OpenVPN client configuration file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryNetwork,
   },
   SimpleSignature{
+    id:          "azure-service-config",
     part:        PartExtension,
     match:       ".cscfg",
     description: "Azure service configuration schema file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCloud,
   },
   SimpleSignature{
+    id:          "remote-desktop-file",
     part:        PartExtension,
     match:       ".rdp",
     description: "Remote Desktop connection file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryNetwork,
   },
   SimpleSignature{
+    id:          "mssql-database",
     part:        PartExtension,
     match:       ".mdf",
     description: "Microsoft SQL database file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryDatabase,
   },
   SimpleSignature{
+    id:          "mssql-compact-database",
     part:        PartExtension,
     match:       ".sdf",
     description: "Microsoft SQL server compact database file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryDatabase,
   },
   SimpleSignature{
+    id:          "sqlite-database",
     part:        PartExtension,
     match:       ".sqlite",
     description: "SQLite database file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryDatabase,
   },
   SimpleSignature{
+    id:          "bitlocker-recovery-key",
     part:        PartExtension,
     match:       ".bek",
     description: "Microsoft BitLocker recovery key file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "bitlocker-tpm-password",
     part:        PartExtension,
     match:       ".tpm",
     description: "Microsoft BitLocker Trusted Platform Module password file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "bitlocker-volume-data",
     part:        PartExtension,
     match:       ".fve",
     description: "Windows BitLocker full volume encrypted data file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "java-keystore",
     part:        PartExtension,
     match:       ".jks",
     description: "Java keystore file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCrypto,
   },
   SimpleSignature{
+    id:          "password-safe-database",
     part:        PartExtension,
     match:       ".psafe3",
     description: "Password Safe database file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryPasswords,
   },
   SimpleSignature{
+    id:          "rails-secret-token",
     part:        PartFilename,
     match:       "secret_token.rb",
     description: "Ruby On Rails secret token configuration file",
     comment:     "If the Rails secret token is known, it can allow for remote code execution (http://www.exploit-db.com/exploits/27527/)",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "carrierwave-config",
     part:        PartFilename,
     match:       "carrierwave.rb",
     description: "Carrierwave configuration file",
     comment:     "Can contain credentials for cloud storage systems such as Amazon S3 and Google Storage",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "rails-database-config",
     part:        PartFilename,
     match:       "database.yml",
     description: "Potential Ruby On Rails database configuration file",
     comment:     "Can contain database credentials",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryDatabase,
   },
   SimpleSignature{
+    id:          "omniauth-config",
     part:        PartFilename,
     match:       "omniauth.rb",
     description: "OmniAuth configuration file",
     comment:     "The OmniAuth configuration file can contain client application secrets",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "django-config",
     part:        PartFilename,
     match:       "settings.py",
     description: "Django configuration file",
     comment:     "Can contain database credentials, cloud storage system credentials, and other secrets",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "1password-database",
     part:        PartExtension,
     match:       ".agilekeychain",
     description: "1Password password manager database file",
     comment:     "Feed it to Hashcat and see if you're lucky",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryPasswords,
   },
   SimpleSignature{
+    id:          "apple-keychain",
     part:        PartExtension,
     match:       ".keychain",
     description: "Apple Keychain database file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryPasswords,
   },
   SimpleSignature{
+    id:          "pcap-file",
     part:        PartExtension,
     match:       ".pcap",
     description: "Network traffic capture file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryNetwork,
   },
   SimpleSignature{
+    id:          "gnucash-database",
     part:        PartExtension,
     match:       ".gnucash",
     description: "GnuCash database file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryPersonal,
   },
   SimpleSignature{
+    id:          "jenkins-publish-over-ssh",
     part:        PartFilename,
     match:       "jenkins.plugins.publish_over_ssh.BapSshPublisherPlugin.xml",
     description: "Jenkins publish over SSH plugin file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   SimpleSignature{
+    id:          "jenkins-credentials",
     part:        PartFilename,
     match:       "credentials.xml",
     description: "Potential Jenkins credentials file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCredentials,
   },
   SimpleSignature{
+    id:          "kde-wallet-database",
     part:        PartExtension,
     match:       ".kwallet",
     description: "KDE Wallet Manager database file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryPasswords,
   },
   SimpleSignature{
+    id:          "mediawiki-config",
     part:        PartFilename,
     match:       "LocalSettings.php",
     description: "Potential MediaWiki configuration file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "tunnelblick-config",
     part:        PartExtension,
     match:       ".tblk",
     description: "Tunnelblick VPN configuration file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryNetwork,
   },
   SimpleSignature{
+    id:          "sequel-pro-bookmarks",
     part:        PartFilename,
     match:       "Favorites.plist",
     description: "Sequel Pro MySQL database manager bookmark file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryDatabase,
   },
   SimpleSignature{
+    id:          "little-snitch-config",
     part:        PartFilename,
     match:       "configuration.user.xpl",
     description: "Little Snitch firewall configuration file",
     comment:     "Contains traffic rules for applications",
+    severity:    SeverityLow,
+    confidence:  ConfidenceHigh,
+    category:    CategoryNetwork,
   },
   SimpleSignature{
+    id:          "day-one-journal",
     part:        PartExtension,
     match:       ".dayone",
     description: "Day One journal file",
     comment:     "Now it's getting creepy...",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryPersonal,
   },
   SimpleSignature{
+    id:          "jrnl-journal",
     part:        PartFilename,
     match:       "journal.txt",
     description: "Potential jrnl journal file",
     comment:     "Now it's getting creepy...",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceLow,
+    category:    CategoryPersonal,
   },
   SimpleSignature{
+    id:          "chef-knife-config",
     part:        PartFilename,
     match:       "knife.rb",
     description: "Chef Knife configuration file",
     comment:     "Can contain references to Chef servers",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "proftpd-credentials",
     part:        PartFilename,
     match:       "proftpdpasswd",
     description: "cPanel backup ProFTPd credentials file",
     comment:     "Contains usernames and password hashes for FTP accounts",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   SimpleSignature{
+    id:          "robomongo-config",
     part:        PartFilename,
     match:       "robomongo.json",
     description: "Robomongo MongoDB manager configuration file",
     comment:     "Can contain credentials for MongoDB databases",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryDatabase,
   },
   SimpleSignature{
+    id:          "filezilla-config",
     part:        PartFilename,
     match:       "filezilla.xml",
     description: "FileZilla FTP configuration file",
     comment:     "Can contain credentials for FTP servers",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   SimpleSignature{
+    id:          "filezilla-recent-servers",
     part:        PartFilename,
     match:       "recentservers.xml",
     description: "FileZilla FTP recent servers file",
     comment:     "Can contain credentials for FTP servers",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   SimpleSignature{
+    id:          "ventrilo-server-config",
     part:        PartFilename,
     match:       "ventrilo_srv.ini",
     description: "Ventrilo server configuration file",
     comment:     "Can contain passwords",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "terraform-variables",
     part:        PartFilename,
     match:       "terraform.tfvars",
     description: "Terraform variable config file",
     comment:     "Can contain credentials for terraform providers",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCloud,
   },
   SimpleSignature{
+    id:          "shell-exports",
     part:        PartFilename,
     match:       ".exports",
     description: "Shell configuration file",
     comment:     "Shell configuration files can contain passwords, API keys, hostnames and other goodies",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "shell-functions",
     part:        PartFilename,
     match:       ".functions",
     description: "Shell configuration file",
     comment:     "Shell configuration files can contain passwords, API keys, hostnames and other goodies",
+    severity:    SeverityLow,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   SimpleSignature{
+    id:          "shell-extra",
     part:        PartFilename,
     match:       ".extra",
     description: "Shell configuration file",
     comment:     "Shell configuration files can contain passwords, API keys, hostnames and other goodies",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   PatternSignature{
+    id:          "ssh-rsa-private-key",
     part:        PartFilename,
     match:       regexp.MustCompile(`^.*_rsa$`),
     description: "Private SSH key",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   PatternSignature{
+    id:          "ssh-dsa-private-key",
     part:        PartFilename,
     match:       regexp.MustCompile(`^.*_dsa$`),
     description: "Private SSH key",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   PatternSignature{
+    id:          "ssh-ed25519-private-key",
     part:        PartFilename,
     match:       regexp.MustCompile(`^.*_ed25519$`),
     description: "Private SSH key",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   PatternSignature{
+    id:          "ssh-ecdsa-private-key",
     part:        PartFilename,
     match:       regexp.MustCompile(`^.*_ecdsa$`),
     description: "Private SSH key",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   PatternSignature{
+    id:          "ssh-config",
     part:        PartPath,
     match:       regexp.MustCompile(`\.?ssh/config$`),
     description: "SSH configuration file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryNetwork,
   },
   PatternSignature{
+    id:          "key-file",
     part:        PartExtension,
     match:       regexp.MustCompile(`^key(pair)?$`),
     description: "Potential cryptographic private key",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCrypto,
   },
   PatternSignature{
+    id:          "shell-history",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?(bash_|zsh_|sh_|z)?history$`),
     description: "Shell command history file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryHistory,
   },
   PatternSignature{
+    id:          "mysql-history",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?mysql_history$`),
     description: "MySQL client command history file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryHistory,
   },
   PatternSignature{
+    id:          "postgresql-history",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?psql_history$`),
     description: "PostgreSQL client command history file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryHistory,
   },
   PatternSignature{
+    id:          "postgresql-password-file",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?pgpass$`),
     description: "PostgreSQL password file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryDatabase,
   },
   PatternSignature{
+    id:          "irb-history",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?irb_history$`),
     description: "Ruby IRB console history file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryHistory,
   },
   PatternSignature{
+    id:          "pidgin-accounts",
     part:        PartPath,
     match:       regexp.MustCompile(`\.?purple/accounts\.xml$`),
     description: "Pidgin chat client account configuration file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "xchat-server-list",
     part:        PartPath,
     match:       regexp.MustCompile(`\.?xchat2?/servlist_?\.conf$`),
     description: "Hexchat/XChat IRC client server list configuration file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "irssi-config",
     part:        PartPath,
     match:       regexp.MustCompile(`\.?irssi/config$`),
     description: "Irssi IRC client configuration file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "recon-ng-keys",
     part:        PartPath,
     match:       regexp.MustCompile(`\.?recon-ng/keys\.db$`),
     description: "Recon-ng web reconnaissance framework API key database",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "dbeaver-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?dbeaver-data-sources.xml$`),
     description: "DBeaver SQL database manager configuration file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryDatabase,
   },
   PatternSignature{
+    id:          "mutt-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?muttrc$`),
     description: "Mutt e-mail client configuration file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "s3cmd-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?s3cfg$`),
     description: "S3cmd configuration file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCloud,
   },
   PatternSignature{
+    id:          "aws-cli-credentials",
     part:        PartPath,
     match:       regexp.MustCompile(`\.?aws/credentials$`),
     description: "AWS CLI credentials file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCloud,
+    references:  []string{"https://docs.aws.amazon.com/cli/latest/userguide/cli-config-files.html"},
   },
   PatternSignature{
+    id:          "sftp-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^sftp-config(\.json)?$`),
     description: "SFTP connection configuration file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "t-twitter-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?trc$`),
     description: "T command-line Twitter client configuration file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "gitrob-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?gitrobrc$`),
     description: "Well, this is awkward... Gitrob configuration file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "shell-rc",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?(bash|zsh|csh)rc$`),
     description: "Shell configuration file",
     comment:     "Shell configuration files can contain passwords, API keys, hostnames and other goodies",
+    severity:    SeverityLow,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   PatternSignature{
+    id:          "shell-profile",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?(bash_|zsh_)?profile$`),
     description: "Shell profile configuration file",
     comment:     "Shell configuration files can contain passwords, API keys, hostnames and other goodies",
+    severity:    SeverityLow,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   PatternSignature{
+    id:          "shell-aliases",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?(bash_|zsh_)?aliases$`),
     description: "Shell command alias configuration file",
     comment:     "Shell configuration files can contain passwords, API keys, hostnames and other goodies",
+    severity:    SeverityLow,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   PatternSignature{
+    id:          "php-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`config(\.inc)?\.php$`),
     description: "PHP configuration file",
     comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   PatternSignature{
+    id:          "gnome-keyring",
     part:        PartExtension,
     match:       regexp.MustCompile(`^key(store|ring)$`),
     description: "GNOME Keyring database file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryPasswords,
   },
   PatternSignature{
+    id:          "keepass-database",
     part:        PartExtension,
     match:       regexp.MustCompile(`^kdbx?$`),
     description: "KeePass password manager database file",
     comment:     "Feed it to Hashcat and see if you're lucky",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryPasswords,
   },
   PatternSignature{
+    id:          "sql-dump",
     part:        PartExtension,
     match:       regexp.MustCompile(`^sql(dump)?$`),
     description: "SQL dump file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryDatabase,
   },
   PatternSignature{
+    id:          "apache-htpasswd",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?htpasswd$`),
     description: "Apache htpasswd file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
+    references:  []string{"https://httpd.apache.org/docs/2.4/programs/htpasswd.html"},
   },
   PatternSignature{
+    id:          "netrc",
     part:        PartFilename,
     match:       regexp.MustCompile(`^(\.|_)?netrc$`),
     description: "Configuration file for auto-login process",
     comment:     "Can contain username and password",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "rubygems-credentials",
     part:        PartPath,
     match:       regexp.MustCompile(`\.?gem/credentials$`),
     description: "Rubygems credentials file",
     comment:     "Can contain API key for a rubygems.org account",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "tugboat-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?tugboat$`),
     description: "Tugboat DigitalOcean management tool configuration",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCloud,
   },
   PatternSignature{
+    id:          "doctl-config",
     part:        PartPath,
     match:       regexp.MustCompile(`doctl/config.yaml$`),
     description: "DigitalOcean doctl command-line client configuration file",
     comment:     "Contains DigitalOcean API key and other information",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCloud,
   },
   PatternSignature{
+    id:          "git-credentials",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?git-credentials$`),
     description: "git-credential-store helper credentials file",
     comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
+    references:  []string{"https://git-scm.com/docs/git-credential-store"},
   },
   PatternSignature{
+    id:          "hub-config",
     part:        PartPath,
     match:       regexp.MustCompile(`config/hub$`),
     description: "GitHub Hub command-line client configuration file",
     comment:     "Can contain GitHub API access token",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "git-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?gitconfig$`),
     description: "Git configuration file",
     comment:     "",
+    severity:    SeverityLow,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   PatternSignature{
+    id:          "chef-private-key",
     part:        PartPath,
     match:       regexp.MustCompile(`\.?chef/(.*)\.pem$`),
     description: "Chef private key",
     comment:     "Can be used to authenticate against Chef servers",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCrypto,
   },
   PatternSignature{
+    id:          "linux-shadow",
     part:        PartPath,
     match:       regexp.MustCompile(`etc/shadow$`),
     description: "Potential Linux shadow file",
     comment:     "Contains hashed passwords for system users",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "linux-passwd",
     part:        PartPath,
     match:       regexp.MustCompile(`etc/passwd$`),
     description: "Potential Linux passwd file",
     comment:     "Contains system user information",
+    severity:    SeverityLow,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   PatternSignature{
+    id:          "docker-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?dockercfg$`),
     description: "Docker configuration file",
     comment:     "Can contain credentials for public or private Docker registries",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "npm-config",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?npmrc$`),
     description: "NPM configuration file",
     comment:     "Can contain credentials for NPM registries",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCredentials,
+    references:  []string{"https://docs.npmjs.com/files/npmrc"},
   },
   PatternSignature{
+    id:          "env-file",
     part:        PartFilename,
     match:       regexp.MustCompile(`^\.?env$`),
     description: "Environment configuration file",
     comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryConfiguration,
   },
   PatternSignature{
+    id:          "word-credential",
     part:        PartPath,
     match:       regexp.MustCompile(`credential`),
     description: "Contains word: credential",
     comment:     "",
+    severity:    SeverityLow,
+    confidence:  ConfidenceLow,
+    category:    CategoryCredentials,
   },
   PatternSignature{
+    id:          "word-password",
     part:        PartPath,
     match:       regexp.MustCompile(`password`),
     description: "Contains word: password",
     comment:     "",
+    severity:    SeverityLow,
+    confidence:  ConfidenceLow,
+    category:    CategoryCredentials,
+  },
+  ContentSignature{
+    id:          "private-key-content",
+    match:       regexp.MustCompile(`-----BEGIN (RSA |DSA |EC |OPENSSH |PGP |ENCRYPTED )?PRIVATE KEY( BLOCK)?-----`),
+    description: "Private key",
+    comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCrypto,
+  },
+  ContentSignature{
+    id:          "aws-access-key-id",
+    match:       regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`),
+    description: "Amazon Web Services access key ID",
+    comment:     "Usually accompanied by a secret access key in the same file",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCloud,
+    references:  []string{"https://docs.aws.amazon.com/general/latest/gr/aws-access-keys-best-practices.html"},
+  },
+  ContentSignature{
+    id:          "aws-secret-access-key",
+    match:       regexp.MustCompile(`(?i)aws_?secret_?(access_?)?key\s*[:=]+\s*["']?[A-Za-z0-9/+=]{40}\b`),
+    description: "Amazon Web Services secret access key",
+    comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCloud,
+    references:  []string{"https://docs.aws.amazon.com/general/latest/gr/aws-access-keys-best-practices.html"},
+  },
+  ContentSignature{
+    id:          "google-api-key",
+    match:       regexp.MustCompile(`\bAIza[0-9A-Za-z_\-]{35}\b`),
+    description: "Google API key",
+    comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryCloud,
+    references:  []string{"https://cloud.google.com/docs/authentication/api-keys"},
+  },
+  ContentSignature{
+    id:          "slack-token",
+    match:       regexp.MustCompile(`\bxox[abposr]-[0-9A-Za-z\-]{10,72}\b`),
+    description: "Slack token",
+    comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceHigh,
+    category:    CategoryTokens,
+    references:  []string{"https://api.slack.com/docs/token-types"},
+  },
+  ContentSignature{
+    id:          "slack-webhook",
+    match:       regexp.MustCompile(`https://hooks\.slack\.com/services/T[0-9A-Za-z]+/B[0-9A-Za-z]+/[0-9A-Za-z]+`),
+    description: "Slack incoming webhook URL",
+    comment:     "",
+    severity:    SeverityMedium,
+    confidence:  ConfidenceHigh,
+    category:    CategoryTokens,
+    references:  []string{"https://api.slack.com/incoming-webhooks"},
+  },
+  ContentSignature{
+    id:          "stripe-live-key",
+    match:       regexp.MustCompile(`\b(sk|rk)_live_[0-9A-Za-z]{24,}\b`),
+    description: "Stripe live API key",
+    comment:     "",
+    severity:    SeverityCritical,
+    confidence:  ConfidenceHigh,
+    category:    CategoryTokens,
+    references:  []string{"https://stripe.com/docs/keys"},
+  },
+  ContentSignature{
+    id:          "twilio-api-key",
+    match:       regexp.MustCompile(`\bSK[0-9a-f]{32}\b`),
+    description: "Twilio API key",
+    comment:     "",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryTokens,
+  },
+  ContentSignature{
+    id:          "url-credentials",
+    match:       regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.\-]*://[^\s:@/"']+:[^\s:@/"']+@[^\s/"']+`),
+    description: "Credentials in URL",
+    comment:     "Connection strings and URLs with embedded username and password",
+    severity:    SeverityHigh,
+    confidence:  ConfidenceMedium,
+    category:    CategoryCredentials,
   },
 }
@@ -0,0 +1,111 @@
+package core
+
+import (
+  "crypto/ecdsa"
+  "crypto/elliptic"
+  "crypto/rand"
+  "crypto/sha256"
+  "crypto/tls"
+  "crypto/x509"
+  "crypto/x509/pkix"
+  "fmt"
+  "math/big"
+  "net"
+  "net/http"
+  "os"
+  "strings"
+  "time"
+)
+
+const SelfSignedCertificateValidity = 365 * 24 * time.Hour
+
+// GenerateSelfSignedCertificate returns a new ECDSA certificate for hosts,
+// which can be host names or IP addresses.
+func GenerateSelfSignedCertificate(hosts []string) (tls.Certificate, error) {
+  key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+  if err != nil {
+    return tls.Certificate{}, err
+  }
+  serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+  if err != nil {
+    return tls.Certificate{}, err
+  }
+  now := time.Now()
+  template := x509.Certificate{
+    SerialNumber: serialNumber,
+    Subject: pkix.Name{
+      Organization: []string{Name},
+      CommonName:   hosts[0],
+    },
+    NotBefore:             now.Add(-time.Hour),
+    NotAfter:              now.Add(SelfSignedCertificateValidity),
+    KeyUsage:              x509.KeyUsageDigitalSignature,
+    ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+    BasicConstraintsValid: true,
+  }
+  for _, host := range hosts {
+    if ip := net.ParseIP(host); ip != nil {
+      template.IPAddresses = append(template.IPAddresses, ip)
+    } else {
+      template.DNSNames = append(template.DNSNames, host)
+    }
+  }
+  der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+  if err != nil {
+    return tls.Certificate{}, err
+  }
+  leaf, err := x509.ParseCertificate(der)
+  if err != nil {
+    return tls.Certificate{}, err
+  }
+  return tls.Certificate{
+    Certificate: [][]byte{der},
+    PrivateKey:  key,
+    Leaf:        leaf,
+  }, nil
+}
+
+// SelfSignedCertificateHosts returns the hosts a self-signed certificate
+// for the web server bound to bindAddress should be valid for. Servers bound
+// to all interfaces also get the host name of the machine.
+func SelfSignedCertificateHosts(bindAddress string) []string {
+  var hosts []string
+  ip := net.ParseIP(bindAddress)
+  if bindAddress == "" || (ip != nil && ip.IsUnspecified()) {
+    if hostname, err := os.Hostname(); err == nil && hostname != "" {
+      hosts = append(hosts, hostname)
+    }
+  } else {
+    hosts = append(hosts, bindAddress)
+  }
+  for _, host := range []string{"localhost", "127.0.0.1", "::1"} {
+    if host != bindAddress {
+      hosts = append(hosts, host)
+    }
+  }
+  return hosts
+}
+
+// CertificateFingerprint returns the SHA-256 fingerprint of the leaf of a
+// certificate in the colon separated format that browsers and openssl show.
+func CertificateFingerprint(certificate tls.Certificate) string {
+  sum := sha256.Sum256(certificate.Certificate[0])
+  parts := make([]string, len(sum))
+  for i, b := range sum {
+    parts[i] = fmt.Sprintf("%02X", b)
+  }
+  return strings.Join(parts, ":")
+}
+
+// NewTLSServer returns an HTTPS server for handler that uses certificate
+// and accepts TLS 1.2 and newer.
+func NewTLSServer(address string, handler http.Handler, certificate tls.Certificate) *http.Server {
+  return &http.Server{
+    Addr:    address,
+    Handler: handler,
+    TLSConfig: &tls.Config{
+      Certificates: []tls.Certificate{certificate},
+      MinVersion:   tls.VersionTLS12,
+    },
+  }
+}
@@ -0,0 +1,104 @@
+package core
+
+import (
+  "errors"
+  "fmt"
+  "strings"
+)
+
+const (
+  TriageStatusConfirmed     = "confirmed"
+  TriageStatusFalsePositive = "false_positive"
+  TriageStatusRemediated    = "remediated"
+
+  MaximumAssigneeLength = 100
+  MaximumNotesLength    = 10000
+)
+
+var ErrFindingNotFound = errors.New("finding not found")
+
+// TriageUpdate is a change to the triage state of a finding. Fields that are
+// nil are left unchanged, and an empty status marks the finding as untriaged.
+type TriageUpdate struct {
+  TriageStatus *string
+  Assignee     *string
+  Notes        *string
+}
+
+func IsValidTriageStatus(status string) bool {
+  switch status {
+  case "", TriageStatusConfirmed, TriageStatusFalsePositive, TriageStatusRemediated:
+    return true
+  }
+  return false
+}
+
+func (u *TriageUpdate) Validate() error {
+  if u.TriageStatus != nil && !IsValidTriageStatus(*u.TriageStatus) {
+    return errors.New(fmt.Sprintf("Unknown triage status: %s. Valid statuses are %s, %s and %s.", *u.TriageStatus, TriageStatusConfirmed, TriageStatusFalsePositive, TriageStatusRemediated))
+  }
+  if u.Assignee != nil && len(*u.Assignee) > MaximumAssigneeLength {
+    return errors.New(fmt.Sprintf("Assignee can't be longer than %d characters.", MaximumAssigneeLength))
+  }
+  if u.Notes != nil && len(*u.Notes) > MaximumNotesLength {
+    return errors.New(fmt.Sprintf("Notes can't be longer than %d characters.", MaximumNotesLength))
+  }
+  return nil
+}
+
+// TriageFinding applies a triage update to the finding with the given ID and
+// returns a copy of the updated finding.
+func (s *Session) TriageFinding(id string, update TriageUpdate) (*Finding, error) {
+  if err := update.Validate(); err != nil {
+    return nil, err
+  }
+  s.Lock()
+  defer s.Unlock()
+  finding := s.findFinding(id)
+  if finding == nil {
+    return nil, ErrFindingNotFound
+  }
+  if update.TriageStatus != nil {
+    finding.TriageStatus = *update.TriageStatus
+  }
+  if update.Assignee != nil {
+    finding.Assignee = strings.TrimSpace(*update.Assignee)
+  }
+  if update.Notes != nil {
+    finding.Notes = *update.Notes
+  }
+  f := *finding
+  return &f, nil
+}
+
+// FindFinding returns a copy of the finding with the given ID, or nil if
+// there is none.
+func (s *Session) FindFinding(id string) *Finding {
+  s.Lock()
+  defer s.Unlock()
+  finding := s.findFinding(id)
+  if finding == nil {
+    return nil
+  }
+  f := *finding
+  return &f
+}
+
+func (s *Session) findFinding(id string) *Finding {
+  for _, f := range s.Findings {
+    if f.Id == id {
+      return f
+    }
+  }
+  return nil
+}
+
+// TriageLocation returns the session file that triage updates are saved
+// to, which is the -save file or otherwise the file loaded with -load. It's
+// empty if triage state can't be persisted.
+func (s *Session) TriageLocation() string {
+  if *s.Options.Save != "" {
+    return *s.Options.Save
+  }
+  return *s.Options.Load
+}
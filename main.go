@@ -3,11 +3,17 @@ package main
 import (
   "fmt"
   "os"
+  "os/signal"
+  "sort"
   "strings"
   "sync"
+  "syscall"
   "time"
 
   "github.com/michenriksen/gitrob/core"
+  "gopkg.in/src-d/go-git.v4"
+  "gopkg.in/src-d/go-git.v4/plumbing"
+  "gopkg.in/src-d/go-git.v4/plumbing/object"
 )
 
 var (
@@ -19,22 +25,22 @@ func GatherTargets(sess *core.Session) {
   sess.Stats.Status = core.StatusGathering
   sess.Out.Important("Gathering targets...\n")
   for _, login := range sess.Options.Logins {
-    target, err := core.GetUserOrOrganization(login, sess.GithubClient)
+    target, err := sess.Provider.GetOwner(login)
     if err != nil {
       sess.Out.Error(" Error retrieving information on %s: %s\n", login, err)
       continue
     }
     sess.Out.Debug("%s (ID: %d) type: %s\n", *target.Login, *target.ID, *target.Type)
     sess.AddTarget(target)
-    if *sess.Options.NoExpandOrgs == false && *target.Type == "Organization" {
+    if *sess.Options.NoExpandOrgs == false && target.HasMembers() {
       sess.Out.Debug("Gathering members of %s (ID: %d)...\n", *target.Login, *target.ID)
-      members, err := core.GetOrganizationMembers(target.Login, sess.GithubClient)
+      members, err := sess.Provider.GetMembers(target)
       if err != nil {
         sess.Out.Error(" Error retrieving members of %s: %s\n", *target.Login, err)
         continue
       }
       for _, member := range members {
-        sess.Out.Debug("Adding organization member %s (ID: %d) to targets\n", *member.Login, *member.ID)
+        sess.Out.Debug("Adding member %s (ID: %d) to targets\n", *member.Login, *member.ID)
         sess.AddTarget(member)
       }
     }
@@ -42,7 +48,7 @@ func GatherTargets(sess *core.Session) {
 }
 
 func GatherRepositories(sess *core.Session) {
-  var ch = make(chan *core.GithubOwner, len(sess.Targets))
+  var ch = make(chan *core.Owner, len(sess.Targets))
   var wg sync.WaitGroup
   var threadNum int
   if len(sess.Targets) == 1 {
@@ -62,19 +68,36 @@ func GatherRepositories(sess *core.Session) {
           wg.Done()
           return
         }
-        repos, err := core.GetRepositoriesFromOwner(target.Login, sess.GithubClient)
+        repos, err := sess.Provider.GetRepositories(target)
         if err != nil {
           sess.Out.Error(" Failed to retrieve repositories from %s: %s\n", *target.Login, err)
         }
-        if len(repos) == 0 {
+        var gists []*core.Repository
+        if !*sess.Options.NoGists {
+          gists, err = sess.Provider.GetGists(target)
+          if err != nil {
+            sess.Out.Error(" Failed to retrieve gists from %s: %s\n", *target.Login, err)
+          }
+        }
+        if len(repos) == 0 && len(gists) == 0 {
           continue
         }
-        for _, repo := range repos {
+        for _, repo := range append(repos, gists...) {
           sess.Out.Debug(" Retrieved repository: %s\n", *repo.FullName)
           sess.AddRepository(repo)
+          if !*sess.Options.Wikis {
+            continue
+          }
+          if wiki := sess.Provider.GetWiki(repo); wiki != nil {
+            sess.Out.Debug(" Added wiki: %s\n", *wiki.FullName)
+            sess.AddRepository(wiki)
+          }
         }
         sess.Stats.IncrementTargets()
         sess.Out.Info(" Retrieved %d %s from %s\n", len(repos), core.Pluralize(len(repos), "repository", "repositories"), *target.Login)
+        if len(gists) > 0 {
+          sess.Out.Info(" Retrieved %d %s from %s\n", len(gists), core.Pluralize(len(gists), "gist", "gists"), *target.Login)
+        }
       }
     }()
   }
@@ -86,22 +109,41 @@ func GatherRepositories(sess *core.Session) {
   wg.Wait()
 }
 
+func GatherLocalRepositories(sess *core.Session) {
+  sess.Stats.Status = core.StatusGathering
+  sess.Out.Important("Gathering local repositories...\n")
+  for _, location := range sess.Options.Logins {
+    repos, err := core.GetLocalRepositories(location, sess.Out)
+    if err != nil {
+      sess.Out.Error(" Failed to find repositories in %s: %s\n", location, err)
+      continue
+    }
+    for _, repo := range repos {
+      sess.Out.Debug(" Found repository: %s\n", *repo.CloneURL)
+      sess.AddRepository(repo)
+    }
+    sess.Out.Info(" Found %d %s in %s\n", len(repos), core.Pluralize(len(repos), "repository", "repositories"), location)
+  }
+}
+
 func AnalyzeRepositories(sess *core.Session) {
   sess.Stats.Status = core.StatusAnalyzing
-  var ch = make(chan *core.GithubRepository, len(sess.Repositories))
+  sess.StartCheckpointing()
+  repositories := sess.RemainingRepositories()
+  var ch = make(chan *core.Repository, len(repositories))
   var wg sync.WaitGroup
   var threadNum int
-  if len(sess.Repositories) <= 1 {
+  if len(repositories) <= 1 {
     threadNum = 1
-  } else if len(sess.Repositories) <= *sess.Options.Threads {
-    threadNum = len(sess.Repositories) - 1
+  } else if len(repositories) <= *sess.Options.Threads {
+    threadNum = len(repositories) - 1
   } else {
     threadNum = *sess.Options.Threads
   }
   wg.Add(threadNum)
   sess.Out.Debug("Threads for repository analysis: %d\n", threadNum)
 
-  sess.Out.Important("Analyzing %d %s...\n", len(sess.Repositories), core.Pluralize(len(sess.Repositories), "repository", "repositories"))
+  sess.Out.Important("Analyzing %d %s...\n", len(repositories), core.Pluralize(len(repositories), "repository", "repositories"))
 
   for i := 0; i < threadNum; i++ {
     go func(tid int) {
@@ -114,134 +156,518 @@ func AnalyzeRepositories(sess *core.Session) {
           return
         }
 
-        sess.Out.Debug("[THREAD #%d][%s] Cloning repository...\n", tid, *repo.FullName)
-        clone, path, err := core.CloneRepository(repo.CloneURL, repo.DefaultBranch, *sess.Options.CommitDepth)
-        if err != nil {
-          if err.Error() != "remote repository is empty" {
-            sess.Out.Error("Error cloning repository %s: %s\n", *repo.FullName, err)
+        var clone *git.Repository
+        var path string
+        var err error
+        if repo.Local {
+          sess.Out.Debug("[THREAD #%d][%s] Opening repository...\n", tid, *repo.FullName)
+          clone, err = core.OpenRepository(repo.CloneURL)
+          if err != nil {
+            sess.Out.Error("Error opening repository %s: %s\n", *repo.CloneURL, err)
+            sess.Stats.IncrementRepositories()
+            sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
+            continue
+          }
+        } else {
+          sess.Out.Debug("[THREAD #%d][%s] Cloning repository...\n", tid, *repo.FullName)
+          clone, path, err = core.CloneRepository(repo.CloneURL, repo.DefaultBranch, *sess.Options.CommitDepth, *sess.Options.AllBranches, *sess.Options.Tags, sess.Provider.CloneAuth())
+          if err != nil {
+            if err.Error() != "remote repository is empty" && !(repo.Wiki && core.IsMissingRepository(err)) {
+              sess.Out.Error("Error cloning repository %s: %s\n", *repo.FullName, err)
+            }
+            sess.Stats.IncrementRepositories()
+            sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
+            continue
+          }
+          sess.Out.Debug("[THREAD #%d][%s] Cloned repository to: %s\n", tid, *repo.FullName, path)
+          if repo.Fork && repo.ParentCloneURL != nil {
+            sess.Out.Debug("[THREAD #%d][%s] Fetching parent repository %s...\n", tid, *repo.FullName, *repo.ParentFullName)
+            err = core.FetchUpstream(clone, *repo.ParentCloneURL, sess.Provider.CloneAuth())
+            if err != nil {
+              sess.Out.Error("Error fetching parent repository %s of %s: %s\n", *repo.ParentFullName, *repo.FullName, err)
+            }
           }
-          sess.Stats.IncrementRepositories()
-          sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
-          continue
         }
-        sess.Out.Debug("[THREAD #%d][%s] Cloned repository to: %s\n", tid, *repo.FullName, path)
 
-        history, err := core.GetRepositoryHistory(clone)
+        history, err := core.GetRepositoryHistory(clone, *sess.Options.CommitDepth, *sess.Options.AllBranches, *sess.Options.Tags)
         if err != nil {
-          sess.Out.Error("[THREAD #%d][%s] Error getting commit history: %s\n", tid, *repo.FullName, err)
-          os.RemoveAll(path)
+          if err != plumbing.ErrReferenceNotFound {
+            sess.Out.Error("[THREAD #%d][%s] Error getting commit history: %s\n", tid, *repo.FullName, err)
+          } else {
+            sess.MarkRepositoryAnalyzed(repo, 0, 0, 0)
+          }
+          if !repo.Local {
+            os.RemoveAll(path)
+          }
           sess.Stats.IncrementRepositories()
           sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
           continue
         }
-        sess.Out.Debug("[THREAD #%d][%s] Number of commits: %d\n", tid, *repo.FullName, len(history))
+        sess.Out.Debug("[THREAD #%d][%s] Number of commits: %d\n", tid, *repo.FullName, len(history.Commits))
+        files, suppressed := 0, 0
 
-        for _, commit := range history {
+        for _, commit := range history.Commits {
           sess.Out.Debug("[THREAD #%d][%s] Analyzing commit: %s\n", tid, *repo.FullName, commit.Hash)
-          changes, _ := core.GetChanges(commit, clone)
+          changes, err := core.GetChanges(commit, clone)
+          if err == core.ErrParentNotFound {
+            sess.Out.Debug("[THREAD #%d][%s] Skipping %s: %s\n", tid, *repo.FullName, commit.Hash, err)
+          } else if err != nil {
+            sess.Out.Error("[THREAD #%d][%s] Error analyzing commit %s: %s\n", tid, *repo.FullName, commit.Hash, err)
+            sess.AddError(repo, commit.Hash.String(), "", err)
+          }
           sess.Out.Debug("[THREAD #%d][%s] Changes in %s: %d\n", tid, *repo.FullName, commit.Hash, len(changes))
           for _, change := range changes {
-            changeAction := core.GetChangeAction(change)
-            path := core.GetChangePath(change)
+            path := core.GetChangePath(change.Change)
             matchFile := core.NewMatchFile(path)
+            matchFile.OldPath = change.OldPath
+            matchFile.Action = change.Action
             if matchFile.IsSkippable() {
               sess.Out.Debug("[THREAD #%d][%s] Skipping %s\n", tid, *repo.FullName, matchFile.Path)
               continue
             }
+            matchFile.Contents, err = core.GetChangeContent(change.Change)
+            if err != nil {
+              sess.Out.Error("[THREAD #%d][%s] Error reading contents of %s in %s: %s\n", tid, *repo.FullName, matchFile.Path, commit.Hash, err)
+              sess.AddError(repo, commit.Hash.String(), matchFile.Path, err)
+            }
+            if matchFile.Contents != nil {
+              matchFile.Additions, err = core.GetChangeAdditions(change.Change)
+              if err != nil {
+                sess.Out.Error("[THREAD #%d][%s] Error reading additions to %s in %s: %s\n", tid, *repo.FullName, matchFile.Path, commit.Hash, err)
+                sess.AddError(repo, commit.Hash.String(), matchFile.Path, err)
+              }
+            }
             sess.Out.Debug("[THREAD #%d][%s] Matching: %s...\n", tid, *repo.FullName, matchFile.Path)
-            for _, signature := range core.Signatures {
-              if signature.Match(matchFile) {
-
-                finding := &core.Finding{
-                  FilePath:        path,
-                  Action:          changeAction,
-                  Description:     signature.Description(),
-                  Comment:         signature.Comment(),
-                  RepositoryOwner: *repo.Owner,
-                  RepositoryName:  *repo.Name,
-                  CommitHash:      commit.Hash.String(),
-                  CommitMessage:   strings.TrimSpace(commit.Message),
-                  CommitAuthor:    commit.Author.String(),
-                }
-                finding.Initialize()
-                sess.AddFinding(finding)
-
-                sess.Out.Warn(" %s: %s\n", strings.ToUpper(changeAction), finding.Description)
-                sess.Out.Info("  Path.......: %s\n", finding.FilePath)
-                sess.Out.Info("  Repo.......: %s\n", *repo.FullName)
-                sess.Out.Info("  Message....: %s\n", core.TruncateString(finding.CommitMessage, 100))
-                sess.Out.Info("  Author.....: %s\n", finding.CommitAuthor)
-                if finding.Comment != "" {
-                  sess.Out.Info("  Comment....: %s\n", finding.Comment)
-                }
-                sess.Out.Info("  File URL...: %s\n", finding.FileUrl)
-                sess.Out.Info("  Commit URL.: %s\n", finding.CommitUrl)
-                sess.Out.Info(" ------------------------------------------------\n\n")
+            findings, suppressedFindings := MatchSignatures(sess, repo, commit, history.Refs[commit.Hash], matchFile)
+            suppressed += suppressedFindings
+            for _, finding := range findings {
+              if sess.AddFinding(finding) {
+                PrintFinding(sess, repo, finding)
                 sess.Stats.IncrementFindings()
-                break
               }
             }
             sess.Stats.IncrementFiles()
+            files++
           }
           sess.Stats.IncrementCommits()
           sess.Out.Debug("[THREAD #%d][%s] Done analyzing changes in %s\n", tid, *repo.FullName, commit.Hash)
         }
         sess.Out.Debug("[THREAD #%d][%s] Done analyzing commits\n", tid, *repo.FullName)
-        os.RemoveAll(path)
-        sess.Out.Debug("[THREAD #%d][%s] Deleted %s\n", tid, *repo.FullName, path)
+        if !repo.Local {
+          os.RemoveAll(path)
+          sess.Out.Debug("[THREAD #%d][%s] Deleted %s\n", tid, *repo.FullName, path)
+        }
+        sess.MarkRepositoryAnalyzed(repo, len(history.Commits), files, suppressed)
         sess.Stats.IncrementRepositories()
         sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
       }
     }(i)
   }
-  for _, repo := range sess.Repositories {
+  for _, repo := range repositories {
     ch <- repo
   }
   close(ch)
   wg.Wait()
 }
 
+// MatchSignatures returns a finding for every signature that matches the
+// file and isn't suppressed by the allowlist, with the most severe first and
+// content matches with a line number before path matches of the same
+// severity, along with the number of findings the allowlist suppressed. The
+// commit is nil for changes that haven't been committed yet, and refs are the
+// branches and tags the commit was found on.
+func MatchSignatures(sess *core.Session, repo *core.Repository, commit *object.Commit, refs []string, matchFile core.MatchFile) ([]*core.Finding, int) {
+  var findings []*core.Finding
+  suppressed := 0
+  for _, signature := range sess.Signatures {
+    if !signature.Match(matchFile) {
+      continue
+    }
+    finding := &core.Finding{
+      FilePath:        matchFile.Path,
+      OldPath:         matchFile.OldPath,
+      Action:          matchFile.Action,
+      SignatureID:     signature.ID(),
+      Description:     signature.Description(),
+      Comment:         signature.Comment(),
+      Severity:        signature.Severity(),
+      Confidence:      signature.Confidence(),
+      Category:        signature.Category(),
+      References:      signature.References(),
+      RepositoryOwner: *repo.Owner,
+      RepositoryName:  *repo.Name,
+      Gist:            repo.Gist,
+      Wiki:            repo.Wiki,
+      Local:           repo.Local,
+    }
+    if commit != nil {
+      finding.CommitHash = commit.Hash.String()
+      finding.CommitMessage = strings.TrimSpace(commit.Message)
+      finding.CommitAuthor = commit.Author.String()
+      finding.CommitDate = commit.Author.When
+      finding.Refs = refs
+    }
+    if repo.URL != nil {
+      finding.RepositoryUrl = *repo.URL
+    }
+    if contentMatcher, ok := signature.(core.ContentMatcher); ok {
+      if match := contentMatcher.FindMatch(matchFile); match != nil {
+        finding.LineNumber = match.LineNumber
+        finding.Snippet = match.Snippet
+      }
+    }
+    finding.Initialize()
+    if sess.Allowlist.IsSuppressed(finding) {
+      sess.Out.Debug("[%s] Suppressed finding %s in %s\n", *repo.FullName, finding.Id, finding.FilePath)
+      sess.Stats.IncrementSuppressed()
+      suppressed++
+      continue
+    }
+    findings = append(findings, finding)
+  }
+  sort.SliceStable(findings, func(i, j int) bool {
+    if rankI, rankJ := core.SeverityRank(findings[i].Severity), core.SeverityRank(findings[j].Severity); rankI != rankJ {
+      return rankI > rankJ
+    }
+    return findings[i].LineNumber > 0 && findings[j].LineNumber == 0
+  })
+  return findings, suppressed
+}
+
+func PrintFinding(sess *core.Session, repo *core.Repository, finding *core.Finding) {
+  sess.Out.Warn(" [%s] %s: %s\n", strings.ToUpper(finding.Severity), strings.ToUpper(finding.Action), finding.Description)
+  sess.Out.Info("  Path.......: %s\n", finding.FilePath)
+  if finding.OldPath != "" {
+    sess.Out.Info("  Old path...: %s\n", finding.OldPath)
+  }
+  if finding.LineNumber > 0 {
+    sess.Out.Info("  Line.......: %d: %s\n", finding.LineNumber, finding.Snippet)
+  }
+  sess.Out.Info("  Repo.......: %s\n", *repo.FullName)
+  if finding.CommitHash != "" {
+    sess.Out.Info("  Message....: %s\n", core.TruncateString(finding.CommitMessage, 100))
+    sess.Out.Info("  Author.....: %s\n", finding.CommitAuthor)
+  }
+  if len(finding.Refs) > 0 {
+    sess.Out.Info("  Refs.......: %s\n", strings.Join(finding.Refs, ", "))
+  }
+  if finding.Comment != "" {
+    sess.Out.Info("  Comment....: %s\n", finding.Comment)
+  }
+  if !finding.Local {
+    sess.Out.Info("  File URL...: %s\n", finding.FileUrl)
+    sess.Out.Info("  Commit URL.: %s\n", finding.CommitUrl)
+  } else if finding.CommitHash != "" {
+    sess.Out.Info("  Commit.....: %s\n", finding.CommitHash)
+  }
+  sess.Out.Info(" ------------------------------------------------\n\n")
+}
+
+func SaveSession(sess *core.Session) {
+  if *sess.Options.Save == "" {
+    return
+  }
+  err := sess.SaveToFile(*sess.Options.Save)
+  if err != nil {
+    sess.Out.Error("Error saving session to %s: %s\n", *sess.Options.Save, err)
+  }
+  sess.Out.Important("Saved session to: %s\n\n", *sess.Options.Save)
+}
+
+// HandleInterrupts saves an unfinished scan as interrupted when gitrob is
+// stopped during analysis, so it can be continued with -resume.
+func HandleInterrupts(sess *core.Session) {
+  signals := make(chan os.Signal, 1)
+  signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+  go func() {
+    <-signals
+    if sess.Stats.Status == core.StatusFinished {
+      os.Exit(0)
+    }
+    if sess.Stats.Status != core.StatusAnalyzing {
+      os.Exit(1)
+    }
+    if err := sess.Interrupt(); err != nil {
+      sess.Out.Fatal("\nError saving interrupted session to %s: %s\n", *sess.Options.Save, err)
+    }
+    if *sess.Options.Save != "" {
+      sess.Out.Important("\nSaved interrupted session to %s, continue with: -resume %s\n", *sess.Options.Save, *sess.Options.Save)
+    }
+    os.Exit(1)
+  }()
+}
+
+// RunHook runs the signatures against the staged changes or pushed commits
+// of the repository in the current directory and returns the exit code for
+// git, which blocks the commit or push if there are findings at or above
+// -fail-severity, or if any of the changes can't be read.
+func RunHook(sess *core.Session) int {
+  repos, err := core.GetLocalRepositories(".", sess.Out)
+  if err != nil || len(repos) == 0 {
+    sess.Out.Error("gitrob: no git repository found in current directory\n")
+    return 1
+  }
+  repo := repos[0]
+  repository, err := core.OpenRepository(repo.CloneURL)
+  if err != nil {
+    sess.Out.Error("gitrob: error opening repository: %s\n", err)
+    return 1
+  }
+
+  switch *sess.Options.Hook {
+  case core.HookPreCommit:
+    changes, err := core.GetStagedChanges(repository)
+    if err != nil {
+      sess.Out.Error("gitrob: error reading staged changes: %s\n", err)
+      return 1
+    }
+    for _, change := range changes {
+      matchFile := core.NewMatchFile(change.To.Name)
+      matchFile.OldPath = change.OldPath
+      matchFile.Action = change.Action
+      if matchFile.IsSkippable() {
+        continue
+      }
+      matchFile.Contents, err = core.GetFileContent(change.To)
+      if err != nil {
+        sess.Out.Error("gitrob: error reading contents of %s: %s\n", matchFile.Path, err)
+        return 1
+      }
+      if matchFile.Contents != nil {
+        matchFile.Additions, err = core.GetFileAdditions(change.From, change.To)
+        if err != nil {
+          sess.Out.Error("gitrob: error reading additions to %s: %s\n", matchFile.Path, err)
+          return 1
+        }
+      }
+      findings, _ := MatchSignatures(sess, repo, nil, nil, matchFile)
+      for _, finding := range findings {
+        sess.AddFinding(finding)
+      }
+    }
+  case core.HookPrePush:
+    commits, err := core.GetPushedCommits(repository, os.Stdin)
+    if err != nil {
+      sess.Out.Error("gitrob: error reading pushed commits: %s\n", err)
+      return 1
+    }
+    for _, commit := range commits {
+      changes, err := core.GetChanges(commit, repository)
+      if err != nil && err != core.ErrParentNotFound {
+        sess.Out.Error("gitrob: error reading changes in %s: %s\n", commit.Hash, err)
+        return 1
+      }
+      for _, change := range changes {
+        matchFile := core.NewMatchFile(core.GetChangePath(change.Change))
+        matchFile.OldPath = change.OldPath
+        matchFile.Action = change.Action
+        if matchFile.IsSkippable() {
+          continue
+        }
+        matchFile.Contents, err = core.GetChangeContent(change.Change)
+        if err != nil {
+          sess.Out.Error("gitrob: error reading contents of %s in %s: %s\n", matchFile.Path, commit.Hash, err)
+          return 1
+        }
+        if matchFile.Contents != nil {
+          matchFile.Additions, err = core.GetChangeAdditions(change.Change)
+          if err != nil {
+            sess.Out.Error("gitrob: error reading additions to %s in %s: %s\n", matchFile.Path, commit.Hash, err)
+            return 1
+          }
+        }
+        findings, _ := MatchSignatures(sess, repo, commit, nil, matchFile)
+        for _, finding := range findings {
+          sess.AddFinding(finding)
+        }
+      }
+    }
+  }
+
+  failing := 0
+  for _, finding := range sess.Findings {
+    if core.SeverityRank(finding.Severity) >= core.SeverityRank(*sess.Options.FailSeverity) {
+      PrintFinding(sess, repo, finding)
+      failing++
+    }
+  }
+  if failing == 0 {
+    return 0
+  }
+  sess.Out.Error("gitrob: %d %s at or above %s severity, aborting %s. Use --no-verify to bypass this check.\n", failing, core.Pluralize(failing, "finding", "findings"), *sess.Options.FailSeverity, strings.TrimPrefix(*sess.Options.Hook, "pre-"))
+  return *sess.Options.FailExitCode
+}
+
+// CIExitCode returns the exit code of a -ci run, which fails if there are
+// findings at or above the -fail-severity.
+func CIExitCode(sess *core.Session) int {
+  failing := sess.CountFindingsAtOrAbove(*sess.Options.FailSeverity)
+  if failing == 0 {
+    sess.Out.Important("No findings with %s severity or higher\n", *sess.Options.FailSeverity)
+    return 0
+  }
+  sess.Out.Error("%d %s with %s severity or higher\n", failing, core.Pluralize(failing, "finding", "findings"), *sess.Options.FailSeverity)
+  return *sess.Options.FailExitCode
+}
+
 func PrintSessionStats(sess *core.Session) {
   sess.Out.Info("\nFindings....: %d\n", sess.Stats.Findings)
+  counts := make(map[string]int)
+  for _, finding := range sess.Findings {
+    counts[finding.Severity]++
+  }
+  for _, severity := range []string{core.SeverityCritical, core.SeverityHigh, core.SeverityMedium, core.SeverityLow} {
+    if counts[severity] > 0 {
+      sess.Out.Info("  %s: %d\n", strings.Title(severity), counts[severity])
+    }
+  }
+  sess.Out.Info("Suppressed..: %d\n", sess.Stats.Suppressed)
+  if sess.Stats.Errors > 0 {
+    sess.Out.Info("Errors......: %d\n", sess.Stats.Errors)
+  }
   sess.Out.Info("Files.......: %d\n", sess.Stats.Files)
   sess.Out.Info("Commits.....: %d\n", sess.Stats.Commits)
   sess.Out.Info("Repositories: %d\n", sess.Stats.Repositories)
   sess.Out.Info("Targets.....: %d\n\n", sess.Stats.Targets)
 }
 
+func ExportSession(sess *core.Session) {
+  if *sess.Options.Export == "" {
+    return
+  }
+  if err := sess.Export(*sess.Options.ExportFormat, *sess.Options.Export, *sess.Options.ExportTables); err != nil {
+    sess.Out.Error("Error exporting findings to %s: %s\n", *sess.Options.Export, err)
+    return
+  }
+  sess.Out.Important("Exported findings to: %s\n", *sess.Options.Export)
+}
+
+func DiffSession(sess *core.Session) {
+  if *sess.Options.Diff == "" {
+    return
+  }
+  previous, err := core.LoadSession(*sess.Options.Diff)
+  if err != nil {
+    sess.Out.Error("Error loading previous session: %s\n", err)
+    return
+  }
+  sess.Diff = core.DiffSessions(previous, sess)
+}
+
+func PrintSessionDiff(sess *core.Session) {
+  sess.Out.Important("Compared with %s:\n", *sess.Options.Diff)
+  for _, finding := range sess.Diff.NewFindings {
+    sess.Out.Warn(" [NEW][%s] %s\n", strings.ToUpper(finding.Severity), finding.Description)
+    sess.Out.Info("  Path.......: %s\n", finding.FilePath)
+    if finding.OldPath != "" {
+      sess.Out.Info("  Old path...: %s\n", finding.OldPath)
+    }
+    sess.Out.Info("  Repo.......: %s/%s\n", finding.RepositoryOwner, finding.RepositoryName)
+    sess.Out.Info("  Commit.....: %s\n\n", finding.CommitHash)
+  }
+  for _, finding := range sess.Diff.ResolvedFindings {
+    sess.Out.Info(" [RESOLVED][%s] %s\n", strings.ToUpper(finding.Severity), finding.Description)
+    sess.Out.Info("  Path.......: %s\n", finding.FilePath)
+    if finding.OldPath != "" {
+      sess.Out.Info("  Old path...: %s\n", finding.OldPath)
+    }
+    sess.Out.Info("  Repo.......: %s/%s\n\n", finding.RepositoryOwner, finding.RepositoryName)
+  }
+  for _, target := range sess.Diff.NewTargets {
+    sess.Out.Info(" [NEW] Target: %s\n", *target.Login)
+  }
+  for _, target := range sess.Diff.RemovedTargets {
+    sess.Out.Info(" [REMOVED] Target: %s\n", *target.Login)
+  }
+  for _, repo := range sess.Diff.NewRepositories {
+    sess.Out.Info(" [NEW] Repository: %s\n", *repo.FullName)
+  }
+  for _, repo := range sess.Diff.RemovedRepositories {
+    sess.Out.Info(" [REMOVED] Repository: %s\n", *repo.FullName)
+  }
+  sess.Out.Info("\nNew findings.........: %d\n", len(sess.Diff.NewFindings))
+  sess.Out.Info("Resolved findings....: %d\n", len(sess.Diff.ResolvedFindings))
+  sess.Out.Info("Unchanged findings...: %d\n", len(sess.Diff.UnchangedFindings))
+  sess.Out.Info("New targets..........: %d\n", len(sess.Diff.NewTargets))
+  sess.Out.Info("Removed targets......: %d\n", len(sess.Diff.RemovedTargets))
+  sess.Out.Info("New repositories.....: %d\n", len(sess.Diff.NewRepositories))
+  sess.Out.Info("Removed repositories.: %d\n\n", len(sess.Diff.RemovedRepositories))
+}
+
 func main() {
   if sess, err = core.NewSession(); err != nil {
     fmt.Println(err)
     os.Exit(1)
   }
 
+  if *sess.Options.Hook != "" {
+    os.Exit(RunHook(sess))
+  }
+
   sess.Out.Info("%s\n\n", core.ASCIIBanner)
   sess.Out.Important("%s v%s started at %s\n", core.Name, core.Version, sess.Stats.StartedAt.Format(time.RFC3339))
-  sess.Out.Important("Loaded %d signatures\n", len(core.Signatures))
-  sess.Out.Important("Web interface available at http://%s:%d\n", *sess.Options.BindAddress, *sess.Options.Port)
-
-  if sess.Stats.Status == "finished" {
-    sess.Out.Important("Loaded session file: %s\n", *sess.Options.Load)
-  } else {
-    if len(sess.Options.Logins) == 0 {
-      sess.Out.Fatal("Please provide at least one GitHub organization or user\n")
+  sess.Out.Important("Loaded %d signatures\n", len(sess.Signatures))
+  if sess.Allowlist != nil {
+    sess.Out.Important("Loaded %d allowlist %s\n", sess.Allowlist.Len(), core.Pluralize(sess.Allowlist.Len(), "entry", "entries"))
+  }
+  if !*sess.Options.NoServer {
+    if sess.AuthToken != "" {
+      sess.Out.Important("Web interface available at %s#token=%s\n", sess.WebURL(), sess.AuthToken)
+      sess.Out.Important("API requests need the header: Authorization: Bearer %s\n", sess.AuthToken)
+    } else {
+      sess.Out.Important("Web interface available at %s\n", sess.WebURL())
+    }
+    if sess.SelfSigned {
+      sess.Out.Important("Using self-signed TLS certificate with SHA-256 fingerprint %s\n", core.CertificateFingerprint(*sess.TLSCertificate))
+    }
+    if sess.Credentials != nil {
+      sess.Out.Important("Loaded %d %s for basic authentication\n", len(sess.Credentials), core.Pluralize(len(sess.Credentials), "user", "users"))
     }
+  }
 
-    GatherTargets(sess)
-    GatherRepositories(sess)
+  if *sess.Options.Load != "" {
+    sess.Out.Important("Loaded session file: %s\n", *sess.Options.Load)
+    DiffSession(sess)
+    ExportSession(sess)
+  } else if *sess.Options.Resume != "" {
+    sess.Out.Important("Resuming session from %s with %d of %d repositories analyzed\n", *sess.Options.Resume, sess.Stats.Repositories, len(sess.Repositories))
+    HandleInterrupts(sess)
     AnalyzeRepositories(sess)
+    DiffSession(sess)
     sess.Finish()
-
-    if *sess.Options.Save != "" {
-      err := sess.SaveToFile(*sess.Options.Save)
-      if err != nil {
-        sess.Out.Error("Error saving session to %s: %s\n", *sess.Options.Save, err)
+    SaveSession(sess)
+    ExportSession(sess)
+  } else {
+    HandleInterrupts(sess)
+    if *sess.Options.Local {
+      if len(sess.Options.Logins) == 0 {
+        sess.Out.Fatal("Please provide at least one local repository or directory\n")
       }
-      sess.Out.Important("Saved session to: %s\n\n", *sess.Options.Save)
+      GatherLocalRepositories(sess)
+    } else {
+      if len(sess.Options.Logins) == 0 {
+        sess.Out.Fatal("Please provide at least one organization, group or user\n")
+      }
+      GatherTargets(sess)
+      GatherRepositories(sess)
     }
+    AnalyzeRepositories(sess)
+    DiffSession(sess)
+    sess.Finish()
+    SaveSession(sess)
+    ExportSession(sess)
   }
 
   PrintSessionStats(sess)
+  if sess.Diff != nil {
+    PrintSessionDiff(sess)
+  }
+  if *sess.Options.NoServer {
+    if *sess.Options.CI {
+      os.Exit(CIExitCode(sess))
+    }
+    return
+  }
   sess.Out.Important("Press Ctrl+C to stop web server and exit.\n\n")
   select {}
 }
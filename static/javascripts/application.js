@@ -1,18 +1,43 @@
+var authToken = (function() {
+  var match = window.location.hash.match(/^#token=([0-9a-f]+)$/);
+  if (match) {
+    sessionStorage.setItem("gitrob_token", match[1]);
+    history.replaceState(null, "", window.location.pathname + window.location.search);
+  }
+  return sessionStorage.getItem("gitrob_token");
+})();
+if (authToken) {
+  $.ajaxSetup({
+    headers: {"Authorization": "Bearer " + authToken}
+  });
+}
+$(document).ajaxError(function(event, xhr) {
+  if (xhr.status === 401) {
+    statsView.stopPolling();
+    $("#auth_required").removeClass("d-none");
+  }
+});
+
 var Stats = Backbone.Model.extend({
   url: "/stats",
   defaults: {
-    "Status":        "initializing",
-    "StartedAt":     null,
-    "FinishedAt":    null,
-    "Progress":      0,
-    "Targets":       0,
-    "Repositories":  0,
-    "Commits":       0,
-    "Files":         0,
-    "Findings":      0,
+    "Status":             "initializing",
+    "StartedAt":          null,
+    "FinishedAt":         null,
+    "Progress":           0,
+    "Targets":            0,
+    "Repositories":       0,
+    "Commits":            0,
+    "Files":              0,
+    "Findings":           0,
+    "Suppressed":         0,
+    "Errors":             0,
+    "RateLimit":          0,
+    "RateLimitRemaining": 0,
+    "RateLimitReset":     null,
   },
   isFinished: function() {
-    return this.get("Status") === "finished";
+    return this.get("Status") === "finished" || this.get("Status") === "interrupted";
   },
   duration: function() {
     if (this.get("StartedAt") === null) {
@@ -36,7 +61,20 @@ window.stats = new Stats;
 
 var Finding = Backbone.Model.extend({
   idAttribute: "Id",
+  defaults: {
+    "OldPath": "",
+    "TriageStatus": "",
+    "Assignee": "",
+    "Notes": "",
+    "Refs": [],
+    "Gist": false,
+    "Wiki": false
+  },
   testFileIndicators: ["test", "_spec", "fixture", "mock", "stub", "fake", "demo", "sample"],
+  severityRanks: {"low": 1, "medium": 2, "high": 3, "critical": 4},
+  severityRank: function() {
+    return this.severityRanks[this.get("Severity")] || 0;
+  },
   shortCommitHash: function() {
     return this.get("CommitHash").substr(0, 7);
   },
@@ -54,7 +92,7 @@ var Finding = Backbone.Model.extend({
     return false;
   },
   fileContentsUrl: function() {
-    return ["/files", this.get("RepositoryOwner"), this.get("RepositoryName"), this.get("CommitHash"), this.get("FilePath")].join("/");
+    return ["/files", encodeURIComponent(this.get("RepositoryOwner")), encodeURIComponent(this.get("RepositoryName")), this.get("CommitHash"), this.get("FilePath")].join("/");
   },
   fileContents: function(callback, error) {
     $.ajax({
@@ -68,10 +106,82 @@ var Finding = Backbone.Model.extend({
 var Findings = Backbone.Collection.extend({
   url: "/findings",
   model: Finding,
+  pageSize: 100,
+  maximumPageSize: 1000,
+  total: 0,
+  nextCursor: null,
+  request: null,
+  query: function() {
+    var query = {sort: "-severity"};
+    var needle = $.trim($("#findings_search").val());
+    var severity = $("#findings_severity").val();
+    var diffStatus = $("#findings_diff").val();
+    if (needle !== "") {
+      query.q = needle;
+    }
+    if (severity !== "") {
+      query.severity = severity;
+    }
+    if (diffStatus === "new" || diffStatus === "unchanged") {
+      query.diff = diffStatus;
+    }
+    return query;
+  },
+  fetchPage: function(limit, cursor) {
+    var data = this.query();
+    data.limit = Math.min(limit, this.maximumPageSize);
+    if (cursor) {
+      data.cursor = cursor;
+    }
+    if (this.request !== null) {
+      this.request.abort();
+    }
+    this.request = this.fetch({
+      data: data,
+      remove: !cursor,
+      success: function(collection, response, options) {
+        collection.request = null;
+        collection.total = parseInt(options.xhr.getResponseHeader("X-Total-Count")) || 0;
+        collection.nextCursor = options.xhr.getResponseHeader("X-Next-Cursor");
+        collection.trigger("page", collection);
+      },
+    });
+  },
+  refresh: function() {
+    this.fetchPage(Math.max(this.length, this.pageSize));
+  },
+  reload: function() {
+    this.fetchPage(this.pageSize);
+  },
+  loadMore: function() {
+    if (this.nextCursor) {
+      this.fetchPage(this.pageSize, this.nextCursor);
+    }
+  },
 });
 
 window.findings = new Findings();
 
+var SessionDiff = Backbone.Model.extend({
+  url: "/diff",
+  defaults: {
+    "NewFindings":         [],
+    "ResolvedFindings":    [],
+    "UnchangedFindings":   [],
+    "NewTargets":          [],
+    "RemovedTargets":      [],
+    "NewRepositories":     [],
+    "RemovedRepositories": [],
+  },
+  parse: function(response) {
+    _.each(_.keys(this.defaults), function(key) {
+      response[key] = response[key] || [];
+    });
+    return response;
+  },
+});
+window.sessionDiff = new SessionDiff;
+
 var StatsView = Backbone.View.extend({
   id: "stats_container",
   model: stats,
@@ -82,6 +192,7 @@ var StatsView = Backbone.View.extend({
     this.listenTo(this.model, "change", this.render)
     this.startDurationTicker();
     this.startPolling();
+    $("#analysis_errors a").on("click", this.showErrors);
   },
   render: function() {
     if (this.model.isFinished()) {
@@ -94,6 +205,9 @@ var StatsView = Backbone.View.extend({
     if (this.model.hasChanged("Findings")) {
       this.updateFindings();
     }
+    if (this.model.hasChanged("Suppressed")) {
+      this.updateSuppressed();
+    }
     if (this.model.hasChanged("Files")) {
       this.updateFiles();
     }
@@ -106,6 +220,12 @@ var StatsView = Backbone.View.extend({
     if (this.model.hasChanged("Targets")) {
       this.updateTargets();
     }
+    if (this.model.hasChanged("RateLimitRemaining") || this.model.hasChanged("RateLimitReset")) {
+      this.updateRateLimit();
+    }
+    if (this.model.hasChanged("Errors")) {
+      this.updateErrors();
+    }
   },
   startPolling: function() {
     this.pollingTicker = setInterval(function() {
@@ -135,13 +255,23 @@ var StatsView = Backbone.View.extend({
     var status = this.statusToHuman();
     $("title").text("Gitrob: " + status);
     $("#progress_bar").text(status).css("width", this.model.get("Progress") + "%");
-    if (this.model.isFinished()) {
+    if (this.model.get("Status") === "interrupted") {
+      $("#progress_bar").removeClass("progress-bar-animated progress-bar-striped");
+    } else if (this.model.isFinished()) {
       $("#progress_bar").removeClass("progress-bar-animated progress-bar-striped").css("width", "100%");
     }
   },
   updateFindings: function() {
     $("#card_findings_value").hide().text(this.model.get("Findings").toLocaleString()).fadeIn("fast");
   },
+  updateSuppressed: function() {
+    var suppressed = this.model.get("Suppressed");
+    if (suppressed > 0) {
+      $("#card_findings_desc").text("Findings (" + suppressed.toLocaleString() + " suppressed)");
+    } else {
+      $("#card_findings_desc").text("Findings");
+    }
+  },
   updateFiles: function() {
     $("#card_files_value").hide().text(this.model.get("Files").toLocaleString()).fadeIn("fast");
   },
@@ -154,6 +284,40 @@ var StatsView = Backbone.View.extend({
   updateTargets: function() {
     $("#card_targets_value").hide().text(this.model.get("Targets").toLocaleString()).fadeIn("fast");
   },
+  showErrors: function(e) {
+    if (!authToken) {
+      return;
+    }
+    e.preventDefault();
+    var errorsWindow = window.open("", "_blank");
+    $.getJSON("/errors", function(errors) {
+      var blob = new Blob([JSON.stringify(errors, null, 2)], {type: "application/json"});
+      errorsWindow.location = URL.createObjectURL(blob);
+    });
+  },
+  updateErrors: function() {
+    var errors = this.model.get("Errors");
+    if (errors === 0) {
+      $("#analysis_errors").hide();
+      return;
+    }
+    $("#analysis_errors a").text(errors.toLocaleString() + (errors === 1 ? " error" : " errors") + " while analyzing commits");
+    $("#analysis_errors").show();
+  },
+  updateRateLimit: function() {
+    var limit = this.model.get("RateLimit");
+    if (limit === 0) {
+      $("#rate_limit").hide();
+      return;
+    }
+    var remaining = this.model.get("RateLimitRemaining");
+    var reset = new Date(Date.parse(this.model.get("RateLimitReset"))).toLocaleTimeString();
+    var text = "API quota: " + remaining.toLocaleString() + " of " + limit.toLocaleString() + " requests remaining, resets at " + reset;
+    if (remaining === 0 && !this.model.isFinished()) {
+      text = "API rate limit exceeded, waiting until " + reset;
+    }
+    $("#rate_limit").text(text).show();
+  },
   statusToHuman: function() {
     var status;
     switch(this.model.get("Status")) {
@@ -169,6 +333,9 @@ var StatsView = Backbone.View.extend({
     case "finished":
       status = "Finished";
       break;
+    case "interrupted":
+      status = "Interrupted";
+      break;
     default:
       status = "Unknown";
       break;
@@ -184,6 +351,10 @@ var FindingView = Backbone.View.extend({
     "click td.col-path a": "showFinding",
   },
   template: _.template($("#template_finding").html()),
+  initialize: function() {
+    this.listenTo(this.model, "change", this.render);
+    this.listenTo(this.model, "remove", this.remove);
+  },
   render: function() {
     this.$el.html(this.template(this.model.attributes)).data("finding", this.model);
     if (this.model.isTestRelated()) {
@@ -231,10 +402,20 @@ var FindingsView = Backbone.View.extend({
   collection: findings,
   initialize: function() {
     this.listenTo(this.collection, "add", this.renderFinding);
+    this.listenTo(this.collection, "sort", this.sortFindings);
+    this.listenTo(this.collection, "page", this.updateCount);
     this.listenTo(stats, "change:Findings", _.debounce(this.update, 500));
     $("#findings_search").on("keyup", _.debounce(this.searchFindings, 200));
+    $("#findings_severity").on("change", this.searchFindings);
+    $("#findings_diff").on("change", this.searchFindings);
+    $("#findings_load_more").on("click", function() {
+      findingsView.collection.loadMore();
+    });
     $("#finding_modal").on("show.bs.modal", function(event) {
       $(document).on("keydown", function(e) {
+        if ($(e.target).is("input, textarea, select")) {
+          return;
+        }
         switch(e.keyCode) {
         case 37:
           var finding = findingsView.previousFinding();
@@ -264,41 +445,93 @@ var FindingsView = Backbone.View.extend({
     });
   },
   update: function() {
-    this.collection.fetch();
+    this.collection.refresh();
   },
   renderFinding: function(finding) {
     var findingEl = new FindingView({model: finding}).render().el;
     $(findingEl).appendTo(this.$el);
   },
+  updateCount: function() {
+    var total = this.collection.total;
+    if (total === 0) {
+      $("#findings_more").addClass("d-none");
+      return;
+    }
+    $("#findings_count").text("Showing " + this.collection.length.toLocaleString() + " of " + total.toLocaleString() + (total === 1 ? " finding" : " findings"));
+    $("#findings_load_more").toggleClass("d-none", !this.collection.nextCursor);
+    $("#findings_more").removeClass("d-none");
+  },
+  sortFindings: function() {
+    var rows = this.$el.children("tr").detach();
+    var rowsById = {};
+    rows.each(function() {
+      rowsById[$(this).data("finding").id] = this;
+    });
+    this.collection.each(function(finding) {
+      if (rowsById[finding.id]) {
+        this.$el.append(rowsById[finding.id]);
+      }
+    }, this);
+  },
   activeFinding: function() {
     return this.$el.find("tr.table-selected");
   },
   nextFinding: function() {
-    return this.activeFinding().nextAll("tr").not(".d-none").first();
+    return this.activeFinding().nextAll("tr").first();
   },
   previousFinding: function() {
-    return this.activeFinding().prevAll("tr").not(".d-none").first();
+    return this.activeFinding().prevAll("tr").first();
   },
   searchFindings: function() {
-    var needle = $.trim($("#findings_search").val()).toLowerCase();
-    if (needle == "") {
-      $("#table_findings tbody tr").removeClass("d-none");
-      return;
+    if ($("#findings_diff").val() !== "resolved") {
+      findingsView.collection.reload();
     }
-    $("#table_findings tbody tr").each(function() {
-      var path = $(this).find("td.col-path").text().toLowerCase();
-      var commit = $(this).find("td.col-commit").text().toLowerCase();
-      var repository = $(this).find("td.col-repository").text().toLowerCase();
-      if (path.indexOf(needle) > -1 || commit.indexOf(needle) > -1 || repository.indexOf(needle) > -1) {
-        $(this).removeClass("d-none");
-      } else {
-        $(this).addClass("d-none");
-      }
-    });
-  }
+  },
 });
 window.findingsView = new FindingsView({el: "#table_findings tbody"});
 
+var SessionDiffView = Backbone.View.extend({
+  model: sessionDiff,
+  template: _.template($("#template_resolved_finding").html()),
+  initialize: function() {
+    this.listenTo(this.model, "sync", this.render);
+    this.listenTo(stats, "change:Status", this.fetch);
+    $("#findings_diff").on("change", this.toggleResolved);
+  },
+  fetch: function() {
+    if (stats.get("Status") === "finished") {
+      this.model.fetch();
+    }
+  },
+  render: function() {
+    var diff = this.model;
+    $("#diff_summary").text(
+      "Compared with previous session: " +
+      diff.get("NewFindings").length + " new, " +
+      diff.get("ResolvedFindings").length + " resolved and " +
+      diff.get("UnchangedFindings").length + " unchanged findings; " +
+      diff.get("NewRepositories").length + " new and " +
+      diff.get("RemovedRepositories").length + " removed repositories; " +
+      diff.get("NewTargets").length + " new and " +
+      diff.get("RemovedTargets").length + " removed targets."
+    ).removeClass("d-none");
+    var rows = _.map(diff.get("ResolvedFindings"), function(finding) {
+      return $("<tr>").html(this.template(finding));
+    }, this);
+    this.$el.empty().append(rows);
+    $("#findings_diff").removeClass("d-none");
+  },
+  toggleResolved: function() {
+    var resolved = $("#findings_diff").val() === "resolved";
+    $("#table_findings").toggleClass("d-none", resolved);
+    $("#table_resolved_findings").toggleClass("d-none", !resolved);
+    if (resolved) {
+      $("#findings_more").addClass("d-none");
+    }
+  },
+});
+window.sessionDiffView = new SessionDiffView({el: "#table_resolved_findings tbody"});
+
 var FindingModal = Backbone.View.extend({
   template: _.template($("#template_finding_modal").html()),
   interestingStringPatterns: [
@@ -315,6 +548,7 @@ var FindingModal = Backbone.View.extend({
   events: {
     "click #finding_view_raw": "showRawContents",
     "click #finding_view_hexdump": "showHexDumpContents",
+    "submit #finding_triage_form": "saveTriage",
   },
   render: function() {
     this.$el.html(this.template(this.model.attributes));
@@ -335,6 +569,28 @@ var FindingModal = Backbone.View.extend({
     $("#modal_file_contents").hide();
     $("#modal_file_hexdump").show();
   },
+  saveTriage: function(e) {
+    e.preventDefault();
+    var button = $("#finding_triage_save").prop("disabled", true);
+    var message = $("#finding_triage_message").removeClass("text-danger text-success").text("");
+    this.model.save({
+      "TriageStatus": $("#finding_triage_status").val(),
+      "Assignee":     $.trim($("#finding_assignee").val()),
+      "Notes":        $("#finding_notes").val(),
+    }, {
+      patch: true,
+      wait: true,
+      success: function() {
+        button.prop("disabled", false);
+        message.addClass("text-success").text("Saved");
+      },
+      error: function(model, response) {
+        button.prop("disabled", false);
+        var error = response.responseJSON && response.responseJSON.message ? response.responseJSON.message : "Triage could not be saved";
+        message.addClass("text-danger").text(error);
+      },
+    });
+  },
   truncatedCommitMessage: function() {
     var message = this.model.trimmedCommitMessage();
     if (message.length <= 150) {
@@ -355,13 +611,14 @@ var FindingModal = Backbone.View.extend({
     return haystack;
   },
   fetchFileContents: function() {
+    var context = this;
     if (this.model.get("Action") == "Delete") {
       $("#modal_file_spinner_container").fadeOut("fast", function() {
-        $("#modal_file_contents_container").html("<div class='alert alert-info' role='alert'>View commit on GitHub to see contents of deleted files.</div>").fadeIn("fast");
+        var message = context.model.get("Local") ? "Contents of deleted files are not shown." : "View commit to see contents of deleted files.";
+        $("#modal_file_contents_container").html("<div class='alert alert-info' role='alert'>" + message + "</div>").fadeIn("fast");
       });
       return;
     }
-    var context = this;
     this.model.fileContents(function(data) {
       var worker = new Worker("/javascripts/highlight_worker.js");
       worker.onmessage = function(event) {
@@ -399,7 +656,7 @@ var FindingModal = Backbone.View.extend({
       worker.postMessage(data);
     }, function() {
       $("#modal_file_spinner_container").fadeOut("fast", function() {
-        $("#modal_file_contents_container").html("<div class='alert alert-warning' role='alert'>File size too large to display inline. View file on GitHub.</div>").fadeIn("fast");
+        $("#modal_file_contents_container").html("<div class='alert alert-warning' role='alert'>File size too large to display inline.</div>").fadeIn("fast");
       });
     });
   }